@@ -1,13 +1,54 @@
 package core
 
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+/*
+An Analyzer builds TokenStreams, which analyze text.
+It thus represents a policy for extracting index terms from text.
+
+Typical implementations first build a Tokenizer,
+which breaks the stream of characters from the Reader into raw Tokens.
+One or more TokenFilters may then be applied to the output of the Tokenizer.
+
+The field name is passed so that an analyzer may treat fields differently,
+the same analyzer is used for index text and for query text.
+*/
+
 // Analyzer text to token
-type Analyzer struct {
+type Analyzer interface {
+	TokenStream(fieldName string, reader io.Reader) (TokenStream, error)
+}
+
+/*
+A field may have several values in a document, e.g. the lines of a poem.
+The positions of the values follow each other, so a phrase could match across two values.
+An analyzer implementing PositionGapAnalyzer leaves a gap of PositionIncrementGap positions
+before every value but the first, a gap larger than the phrase slop keeps phrases within one value.
+Analyzers without the method leave no gap.
+Offsets are not shifted, they stay relative to the text of each value.
+*/
+
+// PositionGapAnalyzer analyzer with a position gap between the values of a field
+type PositionGapAnalyzer interface {
+	Analyzer
+	PositionIncrementGap(fieldName string) int64
 }
 
-// TokenSlice get token slice
-func (ay *Analyzer) TokenSlice() ([]Token, error) {
+/*
+A TokenStream enumerates the sequence of tokens,
+either from fields of a document or from query text.
+
+Next returns a nil token at the end of the stream.
+*/
 
-	return nil, nil
+// TokenStream token stream
+type TokenStream interface {
+	Next() (*Token, error)
+	Close() error
 }
 
 /*
@@ -21,18 +62,194 @@ e.g.,
 to display highlighted query terms in a document browser,
 or to show matching text fragments in a KWIC (KeyWord In Context) display,
 etc.
+Offsets are byte offsets into the source text.
 
 The type is an interned string,
 assigned by a lexical analyzer (a.k.a. tokenizer),
 naming the lexical or syntactic class that the token belongs to.
 For example an end of sentence marker token might be implemented with type "eos".
 The default token type is "word".
+
+The position increment is the position of this token relative to the previous token,
+a value of zero places the token at the same position (e.g. a synonym),
+values greater than one leave a gap (e.g. a removed stop word).
+The position length is the number of positions the token spans,
+it is greater than one for tokens that bridge a multi-word synonym in a token graph.
 */
 
 // Token token
 type Token struct {
-	TermText    string // the text of the term
-	StartOffset int64  // start in source text
-	EndOffset   int64  // end in source text
-	Type        string // lexical type
+	TermText          string // the text of the term
+	StartOffset       int64  // start in source text
+	EndOffset         int64  // end in source text
+	Type              string // lexical type
+	PositionIncrement int64  // position relative to the previous token
+	PositionLength    int64  // number of positions spanned
+}
+
+// TokenizerFactory build a tokenizer reading from reader
+type TokenizerFactory func(reader io.Reader) TokenStream
+
+// TokenFilterFactory wrap a token stream with a filter
+type TokenFilterFactory func(input TokenStream) TokenStream
+
+// CustomAnalyzer analyzer built from char filters, a tokenizer and a chain of filters
+type CustomAnalyzer struct {
+	CharFilters []CharFilterFactory  // applied in order to the text before tokenization
+	Tokenizer   TokenizerFactory     // how to break text into tokens
+	Filters     []TokenFilterFactory // applied in order to the tokenizer output
+	PositionGap int64                // positions between the values of a field
+}
+
+// StandardAnalyzer standard tokenizer with lower case filter
+type StandardAnalyzer struct {
+}
+
+// SimpleAnalyzer letter tokenizer with lower case filter
+type SimpleAnalyzer struct {
+}
+
+// WhitespaceAnalyzer whitespace tokenizer
+type WhitespaceAnalyzer struct {
+}
+
+/*
+A PerFieldAnalyzerWrapper uses a different analyzer for some fields,
+e.g. keyword analysis of an author field and pinyin analysis of a title field,
+and the default analyzer for all other fields.
+It is an Analyzer itself, so it is passed wherever a single analyzer is expected.
+*/
+
+// PerFieldAnalyzerWrapper analyzer per field with a default
+type PerFieldAnalyzerWrapper struct {
+	defaultAnalyzer Analyzer
+	fieldAnalyzers  map[string]Analyzer
+}
+
+// NewPerFieldAnalyzerWrapper new per field analyzer, fieldAnalyzers may be nil
+func NewPerFieldAnalyzerWrapper(defaultAnalyzer Analyzer, fieldAnalyzers map[string]Analyzer) *PerFieldAnalyzerWrapper {
+	pw := &PerFieldAnalyzerWrapper{
+		defaultAnalyzer: defaultAnalyzer,
+		fieldAnalyzers:  map[string]Analyzer{},
+	}
+	for fieldName, analyzer := range fieldAnalyzers {
+		pw.fieldAnalyzers[fieldName] = analyzer
+	}
+	return pw
+}
+
+// newToken new token with default type, increment and length
+func newToken(text string, start, end int64, typ string) *Token {
+	return &Token{
+		TermText:          text,
+		StartOffset:       start,
+		EndOffset:         end,
+		Type:              typ,
+		PositionIncrement: 1,
+		PositionLength:    1,
+	}
+}
+
+// positionIncrementGap gap of analyzer before the next value of a field, 0 when it has none
+func positionIncrementGap(analyzer Analyzer, fieldName string) int64 {
+	if ga, ok := analyzer.(PositionGapAnalyzer); ok {
+		return ga.PositionIncrementGap(fieldName)
+	}
+	return 0
+}
+
+// TokenSlice analyze text and collect all tokens
+func TokenSlice(analyzer Analyzer, fieldName string, text string) ([]Token, error) {
+	stream, err := analyzer.TokenStream(fieldName, strings.NewReader(text))
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Close()
+
+	tokens := []Token{}
+	for {
+		t, err := stream.Next()
+		if err != nil {
+			return nil, err
+		}
+		if t == nil {
+			break
+		}
+		tokens = append(tokens, *t)
+	}
+	return tokens, nil
+}
+
+// ================================CustomAnalyzer=======================================
+
+// TokenStream build tokenizer and filters
+func (ay *CustomAnalyzer) TokenStream(fieldName string, reader io.Reader) (TokenStream, error) {
+	var stream TokenStream
+	for _, charFilter := range ay.CharFilters {
+		reader = charFilter(reader)
+	}
+	if ay.Tokenizer != nil {
+		stream = ay.Tokenizer(reader)
+	} else {
+		stream = NewStandardTokenizer(reader)
+	}
+	for _, filter := range ay.Filters {
+		stream = filter(stream)
+	}
+	return stream, nil
+}
+
+// PositionIncrementGap positions between the values of a field
+func (ay *CustomAnalyzer) PositionIncrementGap(fieldName string) int64 {
+	return ay.PositionGap
+}
+
+// ================================PerFieldAnalyzerWrapper=======================================
+
+// AddAnalyzer use analyzer for the field
+func (pw *PerFieldAnalyzerWrapper) AddAnalyzer(fieldName string, analyzer Analyzer) {
+	pw.fieldAnalyzers[fieldName] = analyzer
+}
+
+// FieldAnalyzer analyzer used for the field
+func (pw *PerFieldAnalyzerWrapper) FieldAnalyzer(fieldName string) Analyzer {
+	if analyzer, found := pw.fieldAnalyzers[fieldName]; found {
+		return analyzer
+	}
+	return pw.defaultAnalyzer
+}
+
+// TokenStream token stream of the field's analyzer
+func (pw *PerFieldAnalyzerWrapper) TokenStream(fieldName string, reader io.Reader) (TokenStream, error) {
+	analyzer := pw.FieldAnalyzer(fieldName)
+	if analyzer == nil {
+		return nil, fmt.Errorf("no analyzer for field %s", fieldName)
+	}
+	return analyzer.TokenStream(fieldName, reader)
+}
+
+// PositionIncrementGap gap of the field's analyzer
+func (pw *PerFieldAnalyzerWrapper) PositionIncrementGap(fieldName string) int64 {
+	return positionIncrementGap(pw.FieldAnalyzer(fieldName), fieldName)
+}
+
+// ================================StandardAnalyzer=======================================
+
+// TokenStream standard tokenizer, lower case filter
+func (ay StandardAnalyzer) TokenStream(fieldName string, reader io.Reader) (TokenStream, error) {
+	return NewLowerCaseFilter(NewStandardTokenizer(reader)), nil
+}
+
+// ================================SimpleAnalyzer=======================================
+
+// TokenStream letter tokenizer, lower case filter
+func (ay SimpleAnalyzer) TokenStream(fieldName string, reader io.Reader) (TokenStream, error) {
+	return NewLowerCaseFilter(NewLetterTokenizer(reader)), nil
+}
+
+// ================================WhitespaceAnalyzer=======================================
+
+// TokenStream whitespace tokenizer
+func (ay WhitespaceAnalyzer) TokenStream(fieldName string, reader io.Reader) (TokenStream, error) {
+	return NewWhitespaceTokenizer(reader), nil
 }
@@ -0,0 +1,685 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+/*
+An AnalyzerRegistry holds analyzers by name,
+so the writer, the query parser and any tool share one definition of how a field is analyzed.
+
+Analyzers are defined in code with RegisterAnalyzer,
+or in a JSON config listing char filters, a tokenizer and filters by type name with their parameters:
+
+	{
+		"analyzers": {
+			"poem": {
+				"char_filters": ["html_strip"],
+				"tokenizer": "standard",
+				"filters": ["lowercase", {"type": "shingle", "min_size": 2, "max_size": 2}],
+				"position_increment_gap": 100
+			}
+		},
+		"default": "standard",
+		"fields": {"title": "poem", "author": "keyword"}
+	}
+
+A component is either its type name or an object with a "type" and its parameters,
+an unknown parameter is an error.
+A component may name an analyzer of the same config, as the "analyzer" of a synonym filter,
+the analyzers are built in the order of these references.
+"fields" and "default" build the PerFieldAnalyzerWrapper returned by Load,
+no analyzer of the config is registered when one of them fails.
+The builtin analyzers, tokenizers, filters and char filters are registered by NewAnalyzerRegistry,
+new component types are added with RegisterTokenizer, RegisterFilter and RegisterCharFilter.
+*/
+
+// AnalyzerRegistry named analyzers and analysis components
+type AnalyzerRegistry struct {
+	analyzers   map[string]Analyzer
+	loading     map[string]Analyzer // analyzers built by Load, registered once all are built
+	tokenizers  map[string]TokenizerBuilder
+	filters     map[string]TokenFilterBuilder
+	charFilters map[string]CharFilterBuilder
+}
+
+// AnalysisParams parameters of an analysis component, a parameter its builder does not read is an error
+type AnalysisParams struct {
+	values map[string]interface{}
+	read   map[string]bool
+}
+
+// TokenizerBuilder build a tokenizer factory from parameters
+type TokenizerBuilder func(params AnalysisParams) (TokenizerFactory, error)
+
+// TokenFilterBuilder build a token filter factory from parameters
+type TokenFilterBuilder func(params AnalysisParams) (TokenFilterFactory, error)
+
+// CharFilterBuilder build a char filter factory from parameters
+type CharFilterBuilder func(params AnalysisParams) (CharFilterFactory, error)
+
+// analysisConfig json analysis config
+type analysisConfig struct {
+	Analyzers map[string]analyzerConfig `json:"analyzers"`
+	Default   string                    `json:"default"`
+	Fields    map[string]string         `json:"fields"`
+}
+
+// analyzerConfig json definition of a custom analyzer
+type analyzerConfig struct {
+	CharFilters []json.RawMessage `json:"char_filters"`
+	Tokenizer   json.RawMessage   `json:"tokenizer"`
+	Filters     []json.RawMessage `json:"filters"`
+	PositionGap int64             `json:"position_increment_gap"`
+}
+
+// NewAnalyzerRegistry new registry with the builtin analyzers and components
+func NewAnalyzerRegistry() *AnalyzerRegistry {
+	ar := &AnalyzerRegistry{
+		analyzers:   map[string]Analyzer{},
+		tokenizers:  map[string]TokenizerBuilder{},
+		filters:     map[string]TokenFilterBuilder{},
+		charFilters: map[string]CharFilterBuilder{},
+	}
+	ar.registerBuiltins()
+	return ar
+}
+
+// LoadAnalysisFile load a json analysis config file into a new registry
+func LoadAnalysisFile(filePath string) (*AnalyzerRegistry, *PerFieldAnalyzerWrapper, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+
+	ar := NewAnalyzerRegistry()
+	pw, err := ar.Load(f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %v", filePath, err)
+	}
+	return ar, pw, nil
+}
+
+// NewAnalysisParams parameters of a component, for a builder called outside of a config
+func NewAnalysisParams(values map[string]interface{}) AnalysisParams {
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	return AnalysisParams{values: values, read: map[string]bool{}}
+}
+
+// ================================AnalyzerRegistry=======================================
+
+// RegisterAnalyzer register an analyzer by name
+func (ar *AnalyzerRegistry) RegisterAnalyzer(name string, analyzer Analyzer) {
+	ar.analyzers[name] = analyzer
+}
+
+// RegisterTokenizer register a tokenizer type
+func (ar *AnalyzerRegistry) RegisterTokenizer(name string, builder TokenizerBuilder) {
+	ar.tokenizers[name] = builder
+}
+
+// RegisterFilter register a token filter type
+func (ar *AnalyzerRegistry) RegisterFilter(name string, builder TokenFilterBuilder) {
+	ar.filters[name] = builder
+}
+
+// RegisterCharFilter register a char filter type
+func (ar *AnalyzerRegistry) RegisterCharFilter(name string, builder CharFilterBuilder) {
+	ar.charFilters[name] = builder
+}
+
+// Analyzer analyzer by name
+func (ar *AnalyzerRegistry) Analyzer(name string) (Analyzer, error) {
+	analyzer, found := ar.loading[name]
+	if !found {
+		analyzer, found = ar.analyzers[name]
+	}
+	if !found {
+		return nil, fmt.Errorf("unknown analyzer %s", name)
+	}
+	return analyzer, nil
+}
+
+// AnalyzerNames names of the registered analyzers, sorted
+func (ar *AnalyzerRegistry) AnalyzerNames() []string {
+	names := []string{}
+	for name := range ar.analyzers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Load register the analyzers of a json config and build its per field analyzer
+func (ar *AnalyzerRegistry) Load(reader io.Reader) (*PerFieldAnalyzerWrapper, error) {
+	var config analysisConfig
+	decoder := json.NewDecoder(reader)
+	decoder.UseNumber()
+	err := decoder.Decode(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := analyzerOrder(config.Analyzers)
+	if err != nil {
+		return nil, err
+	}
+	ar.loading = map[string]Analyzer{}
+	defer func() {
+		ar.loading = nil
+	}()
+	for _, name := range order {
+		analyzer, err := ar.build(config.Analyzers[name])
+		if err != nil {
+			return nil, fmt.Errorf("analyzer %s: %v", name, err)
+		}
+		ar.loading[name] = analyzer
+	}
+
+	if config.Default == "" {
+		config.Default = "standard"
+	}
+	defaultAnalyzer, err := ar.Analyzer(config.Default)
+	if err != nil {
+		return nil, err
+	}
+	pw := NewPerFieldAnalyzerWrapper(defaultAnalyzer, nil)
+	for fieldName, name := range config.Fields {
+		analyzer, err := ar.Analyzer(name)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %v", fieldName, err)
+		}
+		pw.AddAnalyzer(fieldName, analyzer)
+	}
+
+	for name, analyzer := range ar.loading {
+		ar.analyzers[name] = analyzer
+	}
+	return pw, nil
+}
+
+// analyzerOrder names of the analyzers of a config, each after the analyzers of the config its components name,
+// else sorted, so errors are reported in a stable order
+func analyzerOrder(analyzers map[string]analyzerConfig) ([]string, error) {
+	names := []string{}
+	for name := range analyzers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	order := []string{}
+	state := map[string]int{} // 1 while its references are ordered, 2 once ordered
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case 1:
+			return fmt.Errorf("analyzers refer to each other: %s", strings.Join(append(path, name), " -> "))
+		case 2:
+			return nil
+		}
+		state[name] = 1
+		for _, ref := range analyzers[name].references() {
+			if _, found := analyzers[ref]; found {
+				err := visit(ref, append(path, name))
+				if err != nil {
+					return err
+				}
+			}
+		}
+		state[name] = 2
+		order = append(order, name)
+		return nil
+	}
+	for _, name := range names {
+		err := visit(name, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// references analyzers named by the "analyzer" parameter of the components, sorted
+func (config analyzerConfig) references() []string {
+	raws := append([]json.RawMessage{config.Tokenizer}, config.CharFilters...)
+	raws = append(raws, config.Filters...)
+	refs := []string{}
+	for _, raw := range raws {
+		if len(raw) == 0 {
+			continue
+		}
+		_, params, err := componentSpec(raw)
+		if err != nil {
+			continue // reported when built
+		}
+		if name, ok := params.values["analyzer"].(string); ok {
+			refs = append(refs, name)
+		}
+	}
+	sort.Strings(refs)
+	return refs
+}
+
+// build build a custom analyzer from its definition
+func (ar *AnalyzerRegistry) build(config analyzerConfig) (*CustomAnalyzer, error) {
+	if config.PositionGap < 0 {
+		return nil, fmt.Errorf("negative position_increment_gap %d", config.PositionGap)
+	}
+	analyzer := &CustomAnalyzer{PositionGap: config.PositionGap}
+	for _, raw := range config.CharFilters {
+		typ, params, err := componentSpec(raw)
+		if err != nil {
+			return nil, err
+		}
+		builder, found := ar.charFilters[typ]
+		if !found {
+			return nil, fmt.Errorf("unknown char filter %s", typ)
+		}
+		charFilter, err := builder(params)
+		if err == nil {
+			err = params.unread()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("char filter %s: %v", typ, err)
+		}
+		analyzer.CharFilters = append(analyzer.CharFilters, charFilter)
+	}
+
+	if len(config.Tokenizer) > 0 {
+		typ, params, err := componentSpec(config.Tokenizer)
+		if err != nil {
+			return nil, err
+		}
+		builder, found := ar.tokenizers[typ]
+		if !found {
+			return nil, fmt.Errorf("unknown tokenizer %s", typ)
+		}
+		analyzer.Tokenizer, err = builder(params)
+		if err == nil {
+			err = params.unread()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("tokenizer %s: %v", typ, err)
+		}
+	}
+
+	for _, raw := range config.Filters {
+		typ, params, err := componentSpec(raw)
+		if err != nil {
+			return nil, err
+		}
+		builder, found := ar.filters[typ]
+		if !found {
+			return nil, fmt.Errorf("unknown filter %s", typ)
+		}
+		filter, err := builder(params)
+		if err == nil {
+			err = params.unread()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("filter %s: %v", typ, err)
+		}
+		analyzer.Filters = append(analyzer.Filters, filter)
+	}
+	return analyzer, nil
+}
+
+// componentSpec type name and parameters of "name" or {"type": "name", ...}
+func componentSpec(raw json.RawMessage) (string, AnalysisParams, error) {
+	var name string
+	if err := json.Unmarshal(raw, &name); err == nil {
+		return name, NewAnalysisParams(nil), nil
+	}
+
+	values := map[string]interface{}{}
+	decoder := json.NewDecoder(strings.NewReader(string(raw)))
+	decoder.UseNumber()
+	err := decoder.Decode(&values)
+	if err != nil {
+		return "", AnalysisParams{}, fmt.Errorf("invalid component %s", raw)
+	}
+	name, ok := values["type"].(string)
+	if !ok || name == "" {
+		return "", AnalysisParams{}, fmt.Errorf("component without type: %s", raw)
+	}
+	delete(values, "type")
+	return name, NewAnalysisParams(values), nil
+}
+
+// ================================AnalysisParams=======================================
+
+// get value of a parameter, marked read
+func (ap AnalysisParams) get(name string) (interface{}, bool) {
+	if ap.read != nil {
+		ap.read[name] = true
+	}
+	v, found := ap.values[name]
+	return v, found
+}
+
+// unread error of the parameters the builder did not read, unknown to it
+func (ap AnalysisParams) unread() error {
+	unknown := []string{}
+	for name := range ap.values {
+		if !ap.read[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	sort.Strings(unknown)
+	return fmt.Errorf("unknown parameters %s", strings.Join(unknown, ", "))
+}
+
+// Int int parameter or its default
+func (ap AnalysisParams) Int(name string, def int) (int, error) {
+	v, found := ap.get(name)
+	if !found {
+		return def, nil
+	}
+	switch n := v.(type) {
+	case json.Number:
+		i, err := n.Int64()
+		if err != nil {
+			return 0, fmt.Errorf("%s must be an integer", name)
+		}
+		return int(i), nil
+	case int:
+		return n, nil
+	case float64:
+		if n != float64(int(n)) {
+			return 0, fmt.Errorf("%s must be an integer", name)
+		}
+		return int(n), nil
+	}
+	return 0, fmt.Errorf("%s must be an integer", name)
+}
+
+// Bool bool parameter or its default
+func (ap AnalysisParams) Bool(name string, def bool) (bool, error) {
+	v, found := ap.get(name)
+	if !found {
+		return def, nil
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("%s must be true or false", name)
+	}
+	return b, nil
+}
+
+// String string parameter or its default
+func (ap AnalysisParams) String(name string, def string) (string, error) {
+	v, found := ap.get(name)
+	if !found {
+		return def, nil
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%s must be a string", name)
+	}
+	return s, nil
+}
+
+// Strings list of strings parameter
+func (ap AnalysisParams) Strings(name string) ([]string, error) {
+	v, found := ap.get(name)
+	if !found {
+		return nil, nil
+	}
+	if s, ok := v.([]string); ok {
+		return s, nil
+	}
+	list, ok := v.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be a list of strings", name)
+	}
+	strs := []string{}
+	for _, item := range list {
+		s, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s must be a list of strings", name)
+		}
+		strs = append(strs, s)
+	}
+	return strs, nil
+}
+
+// StringMap string to string parameter
+func (ap AnalysisParams) StringMap(name string) (map[string]string, error) {
+	v, found := ap.get(name)
+	if !found {
+		return nil, nil
+	}
+	if m, ok := v.(map[string]string); ok {
+		return m, nil
+	}
+	obj, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object of strings", name)
+	}
+	m := map[string]string{}
+	for k, item := range obj {
+		s, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s must be an object of strings", name)
+		}
+		m[k] = s
+	}
+	return m, nil
+}
+
+// ================================builtins=======================================
+
+// registerBuiltins register the analyzers and components of this package
+func (ar *AnalyzerRegistry) registerBuiltins() {
+	ar.RegisterAnalyzer("standard", StandardAnalyzer{})
+	ar.RegisterAnalyzer("simple", SimpleAnalyzer{})
+	ar.RegisterAnalyzer("whitespace", WhitespaceAnalyzer{})
+	ar.RegisterAnalyzer("keyword", &CustomAnalyzer{
+		Tokenizer: func(reader io.Reader) TokenStream { return NewKeywordTokenizer(reader) },
+	})
+
+	ar.RegisterTokenizer("standard", func(params AnalysisParams) (TokenizerFactory, error) {
+		return func(reader io.Reader) TokenStream { return NewStandardTokenizer(reader) }, nil
+	})
+	ar.RegisterTokenizer("whitespace", func(params AnalysisParams) (TokenizerFactory, error) {
+		return func(reader io.Reader) TokenStream { return NewWhitespaceTokenizer(reader) }, nil
+	})
+	ar.RegisterTokenizer("letter", func(params AnalysisParams) (TokenizerFactory, error) {
+		return func(reader io.Reader) TokenStream { return NewLetterTokenizer(reader) }, nil
+	})
+	ar.RegisterTokenizer("keyword", func(params AnalysisParams) (TokenizerFactory, error) {
+		return func(reader io.Reader) TokenStream { return NewKeywordTokenizer(reader) }, nil
+	})
+
+	ar.RegisterFilter("lowercase", func(params AnalysisParams) (TokenFilterFactory, error) {
+		return func(input TokenStream) TokenStream { return NewLowerCaseFilter(input) }, nil
+	})
+	ar.RegisterFilter("flatten_graph", func(params AnalysisParams) (TokenFilterFactory, error) {
+		return func(input TokenStream) TokenStream { return NewFlattenGraphFilter(input) }, nil
+	})
+	ar.RegisterFilter("icu_normalizer", func(params AnalysisParams) (TokenFilterFactory, error) {
+		return func(input TokenStream) TokenStream { return NewICUNormalizerFilter(input) }, nil
+	})
+	ar.RegisterFilter("ascii_folding", func(params AnalysisParams) (TokenFilterFactory, error) {
+		preserveOriginal, err := params.Bool("preserve_original", false)
+		if err != nil {
+			return nil, err
+		}
+		return func(input TokenStream) TokenStream { return NewASCIIFoldingFilter(input, preserveOriginal) }, nil
+	})
+	ar.RegisterFilter("ngram", func(params AnalysisParams) (TokenFilterFactory, error) {
+		return gramFilter(params, NGramFilterFactory, 1, 2)
+	})
+	ar.RegisterFilter("edge_ngram", func(params AnalysisParams) (TokenFilterFactory, error) {
+		return gramFilter(params, EdgeNGramFilterFactory, 1, 2)
+	})
+	ar.RegisterFilter("shingle", ar.shingleFilter)
+	ar.RegisterFilter("pinyin", ar.pinyinFilter)
+	ar.RegisterFilter("synonym", ar.synonymFilter)
+	ar.RegisterFilter("phonetic", ar.phoneticFilter)
+
+	ar.RegisterCharFilter("html_strip", func(params AnalysisParams) (CharFilterFactory, error) {
+		return func(reader io.Reader) CharFilter { return NewHTMLStripCharFilter(reader) }, nil
+	})
+	ar.RegisterCharFilter("markdown_strip", func(params AnalysisParams) (CharFilterFactory, error) {
+		return func(reader io.Reader) CharFilter { return NewMarkdownStripCharFilter(reader) }, nil
+	})
+	ar.RegisterCharFilter("chinese_variant", func(params AnalysisParams) (CharFilterFactory, error) {
+		return func(reader io.Reader) CharFilter { return NewChineseVariantCharFilter(reader) }, nil
+	})
+	ar.RegisterCharFilter("mapping", func(params AnalysisParams) (CharFilterFactory, error) {
+		mapping, err := params.StringMap("mappings")
+		if err != nil {
+			return nil, err
+		}
+		return func(reader io.Reader) CharFilter { return NewMappingCharFilter(reader, mapping) }, nil
+	})
+}
+
+// gramFilter n-gram filter of min_gram, max_gram and preserve_original
+func gramFilter(params AnalysisParams, factory func(int, int, bool) (TokenFilterFactory, error), minGram, maxGram int) (TokenFilterFactory, error) {
+	minGram, err := params.Int("min_gram", minGram)
+	if err != nil {
+		return nil, err
+	}
+	maxGram, err = params.Int("max_gram", maxGram)
+	if err != nil {
+		return nil, err
+	}
+	preserveOriginal, err := params.Bool("preserve_original", false)
+	if err != nil {
+		return nil, err
+	}
+	return factory(minGram, maxGram, preserveOriginal)
+}
+
+// shingleFilter shingle filter of min_size, max_size, separator and output_unigrams
+func (ar *AnalyzerRegistry) shingleFilter(params AnalysisParams) (TokenFilterFactory, error) {
+	minSize, err := params.Int("min_size", 2)
+	if err != nil {
+		return nil, err
+	}
+	maxSize, err := params.Int("max_size", 2)
+	if err != nil {
+		return nil, err
+	}
+	separator, err := params.String("separator", " ")
+	if err != nil {
+		return nil, err
+	}
+	outputUnigrams, err := params.Bool("output_unigrams", true)
+	if err != nil {
+		return nil, err
+	}
+	return ShingleFilterFactory(minSize, maxSize, separator, outputUnigrams)
+}
+
+// pinyinFilter pinyin filter of mode (full, initials or both), tones and keep_original
+func (ar *AnalyzerRegistry) pinyinFilter(params AnalysisParams) (TokenFilterFactory, error) {
+	modeName, err := params.String("mode", "full")
+	if err != nil {
+		return nil, err
+	}
+	modes := map[string]PinyinMode{"full": PinyinFull, "initials": PinyinInitials, "both": PinyinBoth}
+	mode, found := modes[modeName]
+	if !found {
+		return nil, fmt.Errorf("unknown pinyin mode %s", modeName)
+	}
+	tones, err := params.Bool("tones", false)
+	if err != nil {
+		return nil, err
+	}
+	keepOriginal, err := params.Bool("keep_original", false)
+	if err != nil {
+		return nil, err
+	}
+	return func(input TokenStream) TokenStream { return NewPinyinFilter(input, mode, tones, keepOriginal) }, nil
+}
+
+// phoneticFilter phonetic filter of encoder (soundex, metaphone or double_metaphone), max_code_length and inject
+func (ar *AnalyzerRegistry) phoneticFilter(params AnalysisParams) (TokenFilterFactory, error) {
+	name, err := params.String("encoder", "double_metaphone")
+	if err != nil {
+		return nil, err
+	}
+	maxLength, err := params.Int("max_code_length", 4)
+	if err != nil {
+		return nil, err
+	}
+	inject, err := params.Bool("inject", true)
+	if err != nil {
+		return nil, err
+	}
+
+	var encoder PhoneticEncoder
+	switch name {
+	case "soundex":
+		encoder = Soundex{}
+	case "metaphone":
+		encoder = Metaphone{MaxLength: maxLength}
+	case "double_metaphone":
+		encoder = DoubleMetaphone{MaxLength: maxLength}
+	default:
+		return nil, fmt.Errorf("unknown phonetic encoder %s", name)
+	}
+	return PhoneticFilterFactory(encoder, inject), nil
+}
+
+/*
+synonymFilter synonym filter of inline "synonyms" rules in solr format or a "path" and its "format",
+"expand" (default true) and the registered "analyzer" that parses the rules (default standard).
+*/
+func (ar *AnalyzerRegistry) synonymFilter(params AnalysisParams) (TokenFilterFactory, error) {
+	analyzerName, err := params.String("analyzer", "standard")
+	if err != nil {
+		return nil, err
+	}
+	analyzer, err := ar.Analyzer(analyzerName)
+	if err != nil {
+		return nil, err
+	}
+	expand, err := params.Bool("expand", true)
+	if err != nil {
+		return nil, err
+	}
+	filePath, err := params.String("path", "")
+	if err != nil {
+		return nil, err
+	}
+	format, err := params.String("format", "solr")
+	if err != nil {
+		return nil, err
+	}
+	rules, err := params.Strings("synonyms")
+	if err != nil {
+		return nil, err
+	}
+
+	var synonyms *SynonymMap
+	if filePath != "" {
+		synonyms, err = LoadSynonymFile(filePath, format, analyzer, expand)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		synonyms = NewSynonymMap(analyzer, expand)
+	}
+	if len(rules) > 0 {
+		err = synonyms.ReadSolr(strings.NewReader(strings.Join(rules, "\n")))
+		if err != nil {
+			return nil, err
+		}
+	}
+	return func(input TokenStream) TokenStream { return NewSynonymFilter(input, synonyms) }, nil
+}
@@ -0,0 +1,92 @@
+package core
+
+import "math/bits"
+
+// BitSet fixed size set of document numbers
+type BitSet struct {
+	words []uint64
+	size  int64
+}
+
+// NewBitSet empty set of the numbers in [0, size)
+func NewBitSet(size int64) *BitSet {
+	return &BitSet{
+		words: make([]uint64, (size+63)/64),
+		size:  size,
+	}
+}
+
+// Len size of the set
+func (bs *BitSet) Len() int64 {
+	return bs.size
+}
+
+// Set add i
+func (bs *BitSet) Set(i int64) {
+	bs.words[i>>6] = bs.words[i>>6] | 1<<uint(i&63)
+}
+
+// Clear remove i
+func (bs *BitSet) Clear(i int64) {
+	bs.words[i>>6] = bs.words[i>>6] &^ (1 << uint(i&63))
+}
+
+// Get i is in the set
+func (bs *BitSet) Get(i int64) bool {
+	return bs.words[i>>6]&(1<<uint(i&63)) != 0
+}
+
+// Cardinality number of set bits
+func (bs *BitSet) Cardinality() int64 {
+	n := 0
+	for _, w := range bs.words {
+		n = n + bits.OnesCount64(w)
+	}
+	return int64(n)
+}
+
+// NextSetBit first set bit at or after i, -1 when none
+func (bs *BitSet) NextSetBit(i int64) int64 {
+	if i < 0 {
+		i = 0
+	}
+	if i >= bs.size {
+		return -1
+	}
+	k := i >> 6
+	w := bs.words[k] >> uint(i&63)
+	if w != 0 {
+		return i + int64(bits.TrailingZeros64(w))
+	}
+	k = k + 1
+	for k < int64(len(bs.words)) {
+		if bs.words[k] != 0 {
+			return k<<6 + int64(bits.TrailingZeros64(bs.words[k]))
+		}
+		k = k + 1
+	}
+	return -1
+}
+
+// PrevSetBit last set bit at or before i, -1 when none
+func (bs *BitSet) PrevSetBit(i int64) int64 {
+	if i >= bs.size {
+		i = bs.size - 1
+	}
+	if i < 0 {
+		return -1
+	}
+	k := i >> 6
+	w := bs.words[k] << uint(63-i&63)
+	if w != 0 {
+		return i - int64(bits.LeadingZeros64(w))
+	}
+	k = k - 1
+	for k >= 0 {
+		if bs.words[k] != 0 {
+			return k<<6 + 63 - int64(bits.LeadingZeros64(bs.words[k]))
+		}
+		k = k - 1
+	}
+	return -1
+}
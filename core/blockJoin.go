@@ -0,0 +1,212 @@
+package core
+
+import "fmt"
+
+/*
+Documents added together by AddDocuments are a block, the children first and their parent last,
+numbered consecutively, and merges keep the block together.
+A block join query relates children and parents by these numbers, with a parents filter,
+the bit set of the parent documents of the index:
+the parent of a child is the first parent after it,
+the children of a parent are the documents between the parent before it and itself.
+
+ToParentBlockJoinQuery matches the parents of the children matching a child query,
+scored from the scores of their matching children by a ScoreMode,
+its Groups also give the matching children of each parent,
+say the anthologies holding a poem about the moon, with these poems.
+ToChildBlockJoinQuery matches the children of the parents matching a parent query, with the score of their parent.
+
+The child query of ToParentBlockJoinQuery must not match a parent, and the parent query of ToChildBlockJoinQuery
+must match only parents. An index joined by blocks should add all its documents in blocks,
+a document added alone by AddDocument is taken for a child of the next parent.
+Blocks nest when the parents of one level are the children of the next, with a parents filter per level,
+the children of ToChildBlockJoinQuery are then the documents of every lower level.
+Deleted children are skipped, and so are the children of a deleted parent:
+the parents filter keeps the deleted parents, so the blocks keep their bounds until a merge drops the deleted documents.
+*/
+
+// BitSetProducer bit set of the documents of a reader
+type BitSetProducer interface {
+	BitSet(reader *IndexReader) (*BitSet, error)
+}
+
+// QueryBitSetProducer documents matching a query
+type QueryBitSetProducer struct {
+	query Query
+}
+
+// ScoreMode how the scores of the children make the score of their parent
+type ScoreMode int
+
+const (
+	// ScoreModeNone no score
+	ScoreModeNone ScoreMode = iota
+	// ScoreModeAvg average score of the children
+	ScoreModeAvg
+	// ScoreModeMax best score of the children
+	ScoreModeMax
+	// ScoreModeTotal sum of the scores of the children
+	ScoreModeTotal
+	// ScoreModeMin worst score of the children
+	ScoreModeMin
+)
+
+// JoinGroup a parent and its matching children
+type JoinGroup struct {
+	Parent   ScoreDoc
+	Children []ScoreDoc // in document order
+}
+
+// ToParentBlockJoinQuery parents of the children matching a query
+type ToParentBlockJoinQuery struct {
+	childQuery    Query
+	parentsFilter BitSetProducer
+	scoreMode     ScoreMode
+}
+
+// ToChildBlockJoinQuery children of the parents matching a query
+type ToChildBlockJoinQuery struct {
+	parentQuery   Query
+	parentsFilter BitSetProducer
+}
+
+// NewQueryBitSetProducer bit set of the documents matching a query
+func NewQueryBitSetProducer(query Query) *QueryBitSetProducer {
+	return &QueryBitSetProducer{query: query}
+}
+
+// NewToParentBlockJoinQuery parents of the children matching childQuery
+func NewToParentBlockJoinQuery(childQuery Query, parentsFilter BitSetProducer, scoreMode ScoreMode) *ToParentBlockJoinQuery {
+	return &ToParentBlockJoinQuery{
+		childQuery:    childQuery,
+		parentsFilter: parentsFilter,
+		scoreMode:     scoreMode,
+	}
+}
+
+// NewToChildBlockJoinQuery children of the parents matching parentQuery
+func NewToChildBlockJoinQuery(parentQuery Query, parentsFilter BitSetProducer) *ToChildBlockJoinQuery {
+	return &ToChildBlockJoinQuery{
+		parentQuery:   parentQuery,
+		parentsFilter: parentsFilter,
+	}
+}
+
+// ================================QueryBitSetProducer=======================================
+
+// BitSet documents of the reader matching the query, deleted or not
+func (p *QueryBitSetProducer) BitSet(reader *IndexReader) (*BitSet, error) {
+	hits, err := p.query.Matches(reader.withDeleted())
+	if err != nil {
+		return nil, err
+	}
+	bs := NewBitSet(reader.MaxDoc())
+	for _, hit := range hits {
+		bs.Set(hit.Doc)
+	}
+	return bs, nil
+}
+
+// ================================ToParentBlockJoinQuery=======================================
+
+// Groups parents of the matching children, in document order, with these children
+func (q *ToParentBlockJoinQuery) Groups(reader *IndexReader) ([]JoinGroup, error) {
+	parents, err := q.parentsFilter.BitSet(reader)
+	if err != nil {
+		return nil, err
+	}
+	hits, err := q.childQuery.Matches(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := []JoinGroup{}
+	for _, hit := range hits {
+		if parents.Get(hit.Doc) {
+			return nil, fmt.Errorf("child query matches parent document %d", hit.Doc)
+		}
+		parent := parents.NextSetBit(hit.Doc)
+		if parent < 0 {
+			return nil, fmt.Errorf("child query matches document %d without a parent", hit.Doc)
+		}
+		if reader.IsDeleted(parent) { // an orphan of a deleted parent
+			continue
+		}
+		n := len(groups)
+		if n == 0 || groups[n-1].Parent.Doc != parent {
+			groups = append(groups, JoinGroup{Parent: ScoreDoc{Doc: parent}})
+			n = n + 1
+		}
+		groups[n-1].Children = append(groups[n-1].Children, hit)
+	}
+
+	for i := range groups {
+		groups[i].Parent.Score = q.score(groups[i].Children)
+	}
+	return groups, nil
+}
+
+// Matches parents of the matching children
+func (q *ToParentBlockJoinQuery) Matches(reader *IndexReader) ([]ScoreDoc, error) {
+	groups, err := q.Groups(reader)
+	if err != nil {
+		return nil, err
+	}
+	hits := make([]ScoreDoc, len(groups))
+	for i, group := range groups {
+		hits[i] = group.Parent
+	}
+	return hits, nil
+}
+
+// score score of a parent by the score mode
+func (q *ToParentBlockJoinQuery) score(children []ScoreDoc) float64 {
+	score := 0.0
+	for i, child := range children {
+		switch q.scoreMode {
+		case ScoreModeAvg, ScoreModeTotal:
+			score = score + child.Score
+		case ScoreModeMax:
+			if i == 0 || child.Score > score {
+				score = child.Score
+			}
+		case ScoreModeMin:
+			if i == 0 || child.Score < score {
+				score = child.Score
+			}
+		}
+	}
+	if q.scoreMode == ScoreModeAvg {
+		score = score / float64(len(children))
+	}
+	return score
+}
+
+// ================================ToChildBlockJoinQuery=======================================
+
+// Matches children of the matching parents
+func (q *ToChildBlockJoinQuery) Matches(reader *IndexReader) ([]ScoreDoc, error) {
+	parents, err := q.parentsFilter.BitSet(reader)
+	if err != nil {
+		return nil, err
+	}
+	hits, err := q.parentQuery.Matches(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	children := []ScoreDoc{}
+	for _, hit := range hits {
+		if !parents.Get(hit.Doc) {
+			return nil, fmt.Errorf("parent query matches document %d, not a parent", hit.Doc)
+		}
+		child := parents.PrevSetBit(hit.Doc-1) + 1
+		for child < hit.Doc {
+			if !reader.IsDeleted(child) {
+				children = append(children, ScoreDoc{Doc: child, Score: hit.Score})
+			}
+			child = child + 1
+		}
+	}
+	return children, nil
+}
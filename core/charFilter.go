@@ -0,0 +1,196 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"sort"
+	"unicode/utf8"
+)
+
+/*
+A CharFilter is a reader that transforms the characters of another reader before tokenization.
+
+Because the output may be shorter or longer than the input,
+a char filter keeps a map from offsets in its output to offsets in its input.
+Tokenizers pass their offsets through CorrectOffset,
+so Token.StartOffset and Token.EndOffset always point into the original text.
+Char filters may be chained, each one correcting through the filter it reads from.
+*/
+
+// CharFilter char filter
+type CharFilter interface {
+	io.Reader
+	CorrectOffset(offset int64) int64
+}
+
+// CharFilterFactory wrap a reader with a char filter
+type CharFilterFactory func(reader io.Reader) CharFilter
+
+// offsetCorrector offset correction map shared by char filters
+type offsetCorrector struct {
+	input   io.Reader
+	offsets []int64 // output offsets where the difference changes
+	diffs   []int64 // input offset minus output offset from there on
+}
+
+/*
+A MappingCharFilter replaces character sequences by other sequences,
+the longest matching sequence wins.
+*/
+
+// MappingCharFilter mapping char filter
+type MappingCharFilter struct {
+	offsetCorrector
+	reader    *bufio.Reader
+	mapping   map[string]string
+	maxKey    int          // longest key, in runes
+	pending   []rune       // runes read ahead
+	sizes     []int        // byte size of each pending rune
+	out       bytes.Buffer // output not yet read
+	inOffset  int64        // input offset of the first pending rune
+	outOffset int64        // output offset after everything written to out
+}
+
+// NewMappingCharFilter new mapping char filter
+func NewMappingCharFilter(reader io.Reader, mapping map[string]string) *MappingCharFilter {
+	maxKey := 0
+	for k := range mapping {
+		if n := utf8.RuneCountInString(k); n > maxKey {
+			maxKey = n
+		}
+	}
+	return &MappingCharFilter{
+		offsetCorrector: offsetCorrector{input: reader},
+		reader:          bufio.NewReader(reader),
+		mapping:         mapping,
+		maxKey:          maxKey,
+	}
+}
+
+// correctOffset correct a tokenizer offset through its reader
+func correctOffset(source io.Reader, offset int64) int64 {
+	if cf, ok := source.(CharFilter); ok {
+		return cf.CorrectOffset(offset)
+	}
+	return offset
+}
+
+// ================================offsetCorrector=======================================
+
+// addOffCorrectMap from output offset off on, input offset is off + diff
+func (oc *offsetCorrector) addOffCorrectMap(off int64, diff int64) {
+	n := len(oc.offsets)
+	if n > 0 && oc.offsets[n-1] == off {
+		oc.diffs[n-1] = diff
+		return
+	}
+	oc.offsets = append(oc.offsets, off)
+	oc.diffs = append(oc.diffs, diff)
+}
+
+// currentDiff difference at the end of the output so far
+func (oc *offsetCorrector) currentDiff() int64 {
+	if len(oc.diffs) == 0 {
+		return 0
+	}
+	return oc.diffs[len(oc.diffs)-1]
+}
+
+// CorrectOffset map an output offset to an offset of the original input
+func (oc *offsetCorrector) CorrectOffset(offset int64) int64 {
+	i := sort.Search(len(oc.offsets), func(i int) bool {
+		return oc.offsets[i] > offset
+	})
+	corrected := offset
+	if i > 0 {
+		corrected = offset + oc.diffs[i-1]
+	}
+	return correctOffset(oc.input, corrected)
+}
+
+// Close close input
+func (oc *offsetCorrector) Close() error {
+	return closeSource(oc.input)
+}
+
+// addReplacement record the offsets of input bytes [inStart, inEnd) written as output bytes [outStart, outEnd)
+func (oc *offsetCorrector) addReplacement(inStart, inEnd, outStart, outEnd int64) {
+	inLen := inEnd - inStart
+	outLen := outEnd - outStart
+	if inLen == outLen && inStart-outStart == oc.currentDiff() {
+		return
+	}
+	if outLen > inLen { // extra output bytes all map to the end of the input
+		for k := inLen; k < outLen; k++ {
+			oc.addOffCorrectMap(outStart+k, inEnd-(outStart+k))
+		}
+	}
+	oc.addOffCorrectMap(outEnd, inEnd-outEnd)
+}
+
+// ================================MappingCharFilter=======================================
+
+// Read read mapped bytes
+func (mf *MappingCharFilter) Read(p []byte) (int, error) {
+	for mf.out.Len() == 0 {
+		err := mf.fill()
+		if err != nil {
+			return 0, err
+		}
+		if len(mf.pending) == 0 {
+			return 0, io.EOF
+		}
+		mf.mapNext()
+	}
+	return mf.out.Read(p)
+}
+
+// fill read ahead as many runes as the longest key
+func (mf *MappingCharFilter) fill() error {
+	for len(mf.pending) < mf.maxKey || len(mf.pending) == 0 {
+		r, size, err := mf.reader.ReadRune()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		mf.pending = append(mf.pending, r)
+		mf.sizes = append(mf.sizes, size)
+	}
+	return nil
+}
+
+// mapNext replace the longest key at the start of the pending runes, or copy one rune
+func (mf *MappingCharFilter) mapNext() {
+	for n := len(mf.pending); n > 0; n-- {
+		replacement, found := mf.mapping[string(mf.pending[:n])]
+		if !found {
+			continue
+		}
+		inLen := int64(0)
+		for _, size := range mf.sizes[:n] {
+			inLen = inLen + int64(size)
+		}
+		mf.out.WriteString(replacement)
+		outLen := int64(len(replacement))
+		mf.addReplacement(mf.inOffset, mf.inOffset+inLen, mf.outOffset, mf.outOffset+outLen)
+		mf.consume(n, inLen, outLen)
+		return
+	}
+
+	mf.out.WriteRune(mf.pending[0])
+	inLen := int64(mf.sizes[0])
+	outLen := int64(utf8.RuneLen(mf.pending[0])) // differs for invalid input bytes
+	mf.addReplacement(mf.inOffset, mf.inOffset+inLen, mf.outOffset, mf.outOffset+outLen)
+	mf.consume(1, inLen, outLen)
+}
+
+// consume drop n pending runes
+func (mf *MappingCharFilter) consume(n int, inLen, outLen int64) {
+	mf.pending = mf.pending[n:]
+	mf.sizes = mf.sizes[n:]
+	mf.inOffset = mf.inOffset + inLen
+	mf.outOffset = mf.outOffset + outLen
+}
@@ -0,0 +1,76 @@
+package core
+
+import (
+	"io"
+	"sync"
+)
+
+/*
+A chinese variant char filter folds traditional characters
+and 异体字 variants into one simplified canonical form,
+so that 馬 and 马, or 峯 and 峰, index and search as the same term.
+
+Traditional characters are folded with a table generated from the ICU Traditional-Simplified transform,
+variants with the hand maintained table below, whose targets are folded again.
+The filter runs before tokenization, on index text and query text alike,
+so only the folded term is stored in the index.
+*/
+
+var (
+	chineseFoldOnce sync.Once
+	chineseFolding  map[string]string // rune to canonical rune
+)
+
+// chineseVariants variant forms not covered by the traditional to simplified table
+var chineseVariants = map[rune]rune{
+	'峯': '峰', '竝': '并', '敎': '教', '淸': '清', '靑': '青',
+	'卽': '即', '旣': '既', '嶋': '岛', '峩': '峨', '嶽': '岳',
+	'秊': '年', '疎': '疏', '畧': '略', '畱': '留', '兎': '兔',
+	'氷': '冰', '牕': '窗', '窻': '窗', '窓': '窗', '鑪': '炉',
+	'桮': '杯', '緜': '绵', '鬪': '斗', '甦': '苏', '鄕': '乡',
+	'錬': '炼', '荅': '答', '槀': '槁', '稾': '稿', '嵗': '岁',
+	'歳': '岁', '晩': '晚', '曽': '曾', '覊': '羁', '鴈': '雁',
+	'鸎': '莺', '栢': '柏', '廼': '乃', '迺': '乃', '凴': '凭',
+	'舩': '船', '猨': '猿', '蝯': '猿', '欝': '郁', '舘': '馆',
+	'鍼': '针', '囘': '回', '遶': '绕', '鏁': '锁', '覩': '睹',
+	'挿': '插', '揷': '插', '吿': '告', '曺': '曹', '緖': '绪',
+	'涙': '泪', '敺': '驱', '躭': '耽', '槩': '概', '喦': '岩',
+	'巗': '岩', '羣': '群', '牀': '床', '衆': '众', '眞': '真',
+	'皐': '皋', '臯': '皋', '鷄': '鸡', '異': '异', '冦': '寇',
+	'敍': '叙', '敘': '叙', '琱': '雕', '鵰': '雕',
+}
+
+// NewChineseVariantCharFilter fold traditional and variant characters to simplified
+func NewChineseVariantCharFilter(reader io.Reader) *MappingCharFilter {
+	chineseFoldOnce.Do(loadChineseFolding)
+	return NewMappingCharFilter(reader, chineseFolding)
+}
+
+// loadChineseFolding build the folding map from both tables
+func loadChineseFolding() {
+	simplified := map[rune]rune{}
+	pair := []rune{}
+	for _, r := range simplifiedData {
+		if r == '\n' {
+			continue
+		}
+		pair = append(pair, r)
+		if len(pair) == 2 {
+			simplified[pair[0]] = pair[1]
+			pair = pair[:0]
+		}
+	}
+
+	chineseFolding = map[string]string{}
+	for from, to := range simplified {
+		chineseFolding[string(from)] = string(to)
+	}
+	for from, to := range chineseVariants {
+		if s, found := simplified[to]; found {
+			to = s
+		}
+		if from != to {
+			chineseFolding[string(from)] = string(to)
+		}
+	}
+}
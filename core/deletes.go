@@ -0,0 +1,284 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"path"
+)
+
+/*
+Documents are deleted by a term, every document containing it, or by a query, every document it matches.
+A delete applies to the documents added before it, not to those added after, even in the same buffer.
+
+Deletes are buffered by the writer, and applied when it flushes, before a merge starts and before a merge is committed:
+the deleted documents of each segment are set in a bitset, written to the .del file of the segment.
+The .del file is the number of documents of the segment, the number deleted, and the words of the bitset.
+
+A deleted document keeps its number until its segment is merged, the merge drops it and renumbers the documents after it.
+So maxDoc still counts the deleted documents, numDocs does not, and a reader skips them.
+A child of a block whose parent is deleted is no longer joined, see ToParentBlockJoinQuery.
+*/
+
+// bufferedDelete a delete not applied yet
+type bufferedDelete struct {
+	term    *Term // documents containing the term, or
+	query   Query // documents matching the query
+	gen     int64 // deletes are numbered in order, segments written after delete gen have a larger gen
+	docUpto int64 // of a buffer, the documents added before the delete
+}
+
+// NewTerm term of a field
+func NewTerm(fieldName, text string) Term {
+	return Term{field: fieldName, text: text}
+}
+
+// Field field of the term
+func (t Term) Field() string {
+	return t.field
+}
+
+// Text text of the term
+func (t Term) Text() string {
+	return t.text
+}
+
+// ================================Writer=======================================
+
+// DeleteDocuments delete the documents containing any of the terms,
+// added before the call, the delete is applied when the writer flushes,
+// a term without a field deletes nothing and is an error
+func (w *Writer) DeleteDocuments(terms ...Term) error {
+	for _, term := range terms {
+		if term.field == "" {
+			return fmt.Errorf("delete by a term without field, text %q", term.text)
+		}
+	}
+	for i := range terms {
+		err := w.bufferDelete(bufferedDelete{term: &terms[i]})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteByQuery delete the documents matching any of the queries,
+// added before the call, the delete is applied when the writer flushes
+func (w *Writer) DeleteByQuery(queries ...Query) error {
+	for i, query := range queries {
+		if query == nil {
+			return fmt.Errorf("delete by nil query %d", i)
+		}
+	}
+	for _, query := range queries {
+		err := w.bufferDelete(bufferedDelete{query: query})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bufferDelete record a delete for the written segments, and for the documents of each buffer,
+// an error once the writer is closed, the delete would never be applied
+func (w *Writer) bufferDelete(d bufferedDelete) error {
+	buffers := w.pool.all()
+	for _, dsw := range buffers { // no buffer is flushed while the delete is recorded
+		dsw.mu.Lock()
+	}
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		for _, dsw := range buffers {
+			dsw.mu.Unlock()
+		}
+		return fmt.Errorf("delete after the writer is closed")
+	}
+	w.delGen = w.delGen + 1
+	d.gen = w.delGen
+	w.pendingDeletes = append(w.pendingDeletes, d)
+	w.mu.Unlock()
+	for _, dsw := range buffers {
+		if dsw.NumDocs() > 0 {
+			bd := d
+			bd.docUpto = dsw.NumDocs()
+			dsw.deletes = append(dsw.deletes, bd)
+		}
+		dsw.mu.Unlock()
+	}
+	return nil
+}
+
+// applyDeletes apply the buffered deletes to the segments written before them, with w.mu held
+func (w *Writer) applyDeletes() error {
+	if len(w.pendingDeletes) == 0 {
+		return nil
+	}
+	for i, si := range w.segInfos.segInfos {
+		deletes := []bufferedDelete{}
+		for _, d := range w.pendingDeletes {
+			if d.gen > si.gen {
+				d.docUpto = si.docCount
+				deletes = append(deletes, d)
+			}
+		}
+		delCount, err := deleteSegmentDocs(si, deletes)
+		if err != nil {
+			return err
+		}
+		w.segInfos.segInfos[i].delCount = delCount
+	}
+	w.message("apply %d deletes to %d segments", len(w.pendingDeletes), len(w.segInfos.segInfos))
+	w.pendingDeletes = nil
+	return nil
+}
+
+// deleteSegmentDocs delete the documents of a segment matched by deletes, below their docUpto,
+// and return the number of deleted documents of the segment
+func deleteSegmentDocs(si SegmentInfo, deletes []bufferedDelete) (int64, error) {
+	if len(deletes) == 0 {
+		return si.delCount, nil
+	}
+	reader := new(SegmentReader)
+	err := reader.init(si)
+	if err != nil {
+		reader.close()
+		return 0, err
+	}
+	defer reader.close()
+
+	deleted := reader.deletedDocs
+	if deleted == nil {
+		deleted = NewBitSet(reader.maxDoc())
+	}
+	before := deleted.Cardinality()
+	for _, d := range deletes {
+		docs := []int64{}
+		if d.term != nil {
+			termDocs, err := reader.TermDocs(d.term.field, d.term.text)
+			if err != nil {
+				return 0, err
+			}
+			for _, td := range termDocs {
+				docs = append(docs, td.Doc)
+			}
+		} else {
+			hits, err := d.query.Matches(newSegmentIndexReader(reader))
+			if err != nil {
+				return 0, err
+			}
+			for _, hit := range hits {
+				docs = append(docs, hit.Doc)
+			}
+		}
+		for _, doc := range docs {
+			if doc < d.docUpto {
+				deleted.Set(doc)
+			}
+		}
+	}
+
+	delCount := deleted.Cardinality()
+	if delCount == before {
+		return delCount, nil
+	}
+	return delCount, writeDeletedDocs(si.dirPath, si.name, deleted)
+}
+
+// newSegmentIndexReader index reader of a single segment, for the queries of deletes
+func newSegmentIndexReader(reader *SegmentReader) *IndexReader {
+	return &IndexReader{
+		dirPath:  reader.seg.dirPath,
+		segInfos: &SegmentInfos{segInfos: []SegmentInfo{*reader.seg}},
+		readers:  []*SegmentReader{reader},
+		starts:   []int64{0},
+		maxDoc:   reader.maxDoc(),
+	}
+}
+
+// ================================del file=======================================
+
+// deletedDocsPath del file of a segment
+func deletedDocsPath(dirPath string, segment string) string {
+	return path.Join(dirPath, segment+FileSuffix["deletedDocs"])
+}
+
+// writeDeletedDocs write the deleted documents of a segment, replacing its del file
+func writeDeletedDocs(dirPath string, segment string, deleted *BitSet) error {
+	filePath := deletedDocsPath(dirPath, segment)
+	fPtr, err := CreateFile(filePath+".new", false, false)
+	if err != nil {
+		return err
+	}
+	fPtr.writeInt64(deleted.Len())
+	fPtr.writeInt64(deleted.Cardinality())
+	for _, word := range deleted.words {
+		err = fPtr.writeInt64(int64(word))
+		if err != nil {
+			fPtr.close()
+			return err
+		}
+	}
+	err = fPtr.close()
+	if err != nil {
+		return err
+	}
+	return fPtr.rename(filePath)
+}
+
+// readDeletedDocs read the deleted documents of a segment, nil when none is deleted
+func readDeletedDocs(dirPath string, segment string) (*BitSet, error) {
+	fPtr, err := CreateFile(deletedDocsPath(dirPath, segment), false, true)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer fPtr.close()
+
+	size, err := fPtr.readInt64()
+	if err != nil {
+		return nil, err
+	}
+	_, err = fPtr.readInt64()
+	if err != nil {
+		return nil, err
+	}
+	deleted := NewBitSet(size)
+	for i := range deleted.words {
+		word, err := fPtr.readInt64()
+		if err != nil {
+			return nil, err
+		}
+		deleted.words[i] = uint64(word)
+	}
+	return deleted, nil
+}
+
+// readDeletedCount number of deleted documents of a segment
+func readDeletedCount(dirPath string, segment string) (int64, error) {
+	fPtr, err := CreateFile(deletedDocsPath(dirPath, segment), false, true)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer fPtr.close()
+
+	_, err = fPtr.readInt64()
+	if err != nil {
+		return 0, err
+	}
+	return fPtr.readInt64()
+}
+
+// removeDeletedDocs remove the del file left by a former segment of the same name
+func removeDeletedDocs(dirPath string, segment string) error {
+	err := os.Remove(deletedDocsPath(dirPath, segment))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
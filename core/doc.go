@@ -17,3 +17,26 @@ func (d *Document) Add(field Field) error {
 	d.Fields = append(d.Fields, field)
 	return nil
 }
+
+// GetFields all fields named name, in the order they were added
+func (d *Document) GetFields(name string) []Field {
+	fields := []Field{}
+	for _, field := range d.Fields {
+		if field.name == name {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// GetValues string values of all fields named name, in the order they were added,
+// a doc values field has no string value and is left out, GetFields has it
+func (d *Document) GetValues(name string) []string {
+	values := []string{}
+	for _, field := range d.Fields {
+		if field.name == name && field.docValuesType == DocValuesNone {
+			values = append(values, field.value)
+		}
+	}
+	return values
+}
@@ -0,0 +1,486 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+)
+
+/*
+Doc values are the values of a field kept column-wise, one column per field and segment,
+so sorting or faceting reads the value of a document without reading its stored fields.
+
+	numeric     an int64 per document, e.g. a year
+	sorted      a string per document, kept as an ordinal into the sorted distinct values, e.g. a dynasty
+	sorted set  any number of strings per document, as sorted ordinals, e.g. tags
+	binary      a []byte per document
+
+A document has at most one value of a numeric, sorted or binary field, and may have none.
+The column of field number N is written to the .dvN file of the segment:
+a type byte, the document count, then
+
+	numeric     per document an exists byte and an int64
+	sorted(set) the distinct values, then per document the ordinal count and the ordinal deltas
+	binary      per document an exists byte and the bytes
+
+A column is read into memory the first time it is asked for.
+The values are random-access by document number.
+*/
+
+// DocValuesType kind of doc values of a field
+type DocValuesType int
+
+const (
+	// DocValuesNone no doc values
+	DocValuesNone DocValuesType = iota
+	// DocValuesNumeric an int64 per document
+	DocValuesNumeric
+	// DocValuesSorted a string per document, by ordinal
+	DocValuesSorted
+	// DocValuesSortedSet strings per document, by ordinal
+	DocValuesSortedSet
+	// DocValuesBinary bytes per document
+	DocValuesBinary
+)
+
+// NumericDocValues numeric column of a field
+type NumericDocValues struct {
+	column *docValuesColumn
+}
+
+// SortedDocValues sorted column of a field
+type SortedDocValues struct {
+	column *docValuesColumn
+}
+
+// SortedSetDocValues sorted set column of a field
+type SortedSetDocValues struct {
+	column *docValuesColumn
+}
+
+// BinaryDocValues binary column of a field
+type BinaryDocValues struct {
+	column *docValuesColumn
+}
+
+// docValuesColumn values of a field for every document of a segment
+type docValuesColumn struct {
+	typ      DocValuesType
+	numerics []int64  // numeric value of each document
+	exists   []bool   // the document has a numeric or binary value
+	binaries [][]byte // binary value of each document
+	terms    []string // sorted distinct values of a sorted or sorted set column
+	starts   []int64  // the ordinals of document d are ords[starts[d]:starts[d+1]]
+	ords     []int64
+}
+
+var docValuesTypeNames = map[DocValuesType]string{
+	DocValuesNumeric:   "numeric",
+	DocValuesSorted:    "sorted",
+	DocValuesSortedSet: "sorted set",
+	DocValuesBinary:    "binary",
+}
+
+// String name of the type
+func (t DocValuesType) String() string {
+	if name, found := docValuesTypeNames[t]; found {
+		return name
+	}
+	return "none"
+}
+
+// ================================NumericDocValues=======================================
+
+// Get value of document doc, false when it has none
+func (dv *NumericDocValues) Get(doc int64) (int64, bool) {
+	if doc < 0 || doc >= int64(len(dv.column.numerics)) || !dv.column.exists[doc] {
+		return 0, false
+	}
+	return dv.column.numerics[doc], true
+}
+
+// ================================SortedDocValues=======================================
+
+// Ord ordinal of the value of document doc, -1 when it has none
+func (dv *SortedDocValues) Ord(doc int64) int64 {
+	ords := dv.column.docOrds(doc)
+	if len(ords) == 0 {
+		return -1
+	}
+	return ords[0]
+}
+
+// Get value of document doc, false when it has none
+func (dv *SortedDocValues) Get(doc int64) (string, bool) {
+	ord := dv.Ord(doc)
+	if ord < 0 {
+		return "", false
+	}
+	return dv.column.terms[ord], true
+}
+
+// LookupOrd value of an ordinal
+func (dv *SortedDocValues) LookupOrd(ord int64) string {
+	return dv.column.terms[ord]
+}
+
+// ValueCount number of distinct values, ordinals are 0 to ValueCount-1
+func (dv *SortedDocValues) ValueCount() int64 {
+	return int64(len(dv.column.terms))
+}
+
+// ================================SortedSetDocValues=======================================
+
+// Ords sorted ordinals of the values of document doc
+func (dv *SortedSetDocValues) Ords(doc int64) []int64 {
+	return dv.column.docOrds(doc)
+}
+
+// Get sorted values of document doc
+func (dv *SortedSetDocValues) Get(doc int64) []string {
+	values := []string{}
+	for _, ord := range dv.column.docOrds(doc) {
+		values = append(values, dv.column.terms[ord])
+	}
+	return values
+}
+
+// LookupOrd value of an ordinal
+func (dv *SortedSetDocValues) LookupOrd(ord int64) string {
+	return dv.column.terms[ord]
+}
+
+// ValueCount number of distinct values, ordinals are 0 to ValueCount-1
+func (dv *SortedSetDocValues) ValueCount() int64 {
+	return int64(len(dv.column.terms))
+}
+
+// ================================BinaryDocValues=======================================
+
+// Get value of document doc, false when it has none
+func (dv *BinaryDocValues) Get(doc int64) ([]byte, bool) {
+	if doc < 0 || doc >= int64(len(dv.column.binaries)) || !dv.column.exists[doc] {
+		return nil, false
+	}
+	return dv.column.binaries[doc], true
+}
+
+// ================================docValuesColumn=======================================
+
+// newDocValuesColumn empty column of a type
+func newDocValuesColumn(typ DocValuesType) *docValuesColumn {
+	return &docValuesColumn{typ: typ, starts: []int64{0}}
+}
+
+// docOrds ordinals of document doc
+func (c *docValuesColumn) docOrds(doc int64) []int64 {
+	if doc < 0 || doc+1 >= int64(len(c.starts)) {
+		return nil
+	}
+	return c.ords[c.starts[doc]:c.starts[doc+1]]
+}
+
+// size number of documents of the column
+func (c *docValuesColumn) size() int64 {
+	switch c.typ {
+	case DocValuesNumeric:
+		return int64(len(c.numerics))
+	case DocValuesBinary:
+		return int64(len(c.binaries))
+	}
+	return int64(len(c.starts) - 1)
+}
+
+// withoutDeleted copy of the column without the deleted documents
+func (c *docValuesColumn) withoutDeleted(deleted *BitSet) *docValuesColumn {
+	live := newDocValuesColumn(c.typ)
+	live.terms = c.terms
+	for doc := int64(0); doc < c.size(); doc++ {
+		if deleted.Get(doc) {
+			continue
+		}
+		switch c.typ {
+		case DocValuesNumeric:
+			live.numerics = append(live.numerics, c.numerics[doc])
+			live.exists = append(live.exists, c.exists[doc])
+		case DocValuesBinary:
+			live.binaries = append(live.binaries, c.binaries[doc])
+			live.exists = append(live.exists, c.exists[doc])
+		default:
+			live.ords = append(live.ords, c.docOrds(doc)...)
+			live.starts = append(live.starts, int64(len(live.ords)))
+		}
+	}
+	return live
+}
+
+// addMissing add documents without a value
+func (c *docValuesColumn) addMissing(n int64) {
+	for i := int64(0); i < n; i++ {
+		switch c.typ {
+		case DocValuesNumeric:
+			c.numerics = append(c.numerics, 0)
+			c.exists = append(c.exists, false)
+		case DocValuesBinary:
+			c.binaries = append(c.binaries, nil)
+			c.exists = append(c.exists, false)
+		default:
+			c.starts = append(c.starts, int64(len(c.ords)))
+		}
+	}
+}
+
+// docValuesColumns columns of the doc values fields of a single document
+func docValuesColumns(doc Document) (map[string]*docValuesColumn, error) {
+	columns := map[string]*docValuesColumn{}
+	values := map[string][]string{}
+	for _, field := range doc.Fields {
+		if field.docValuesType == DocValuesNone {
+			continue
+		}
+		c, found := columns[field.name]
+		if !found {
+			c = newDocValuesColumn(field.docValuesType)
+			columns[field.name] = c
+		} else if c.typ != field.docValuesType {
+			return nil, fmt.Errorf("field %s has %s and %s doc values", field.name, c.typ, field.docValuesType)
+		} else if c.typ != DocValuesSortedSet {
+			return nil, fmt.Errorf("field %s has more than one %s doc value", field.name, c.typ)
+		}
+		switch c.typ {
+		case DocValuesNumeric:
+			c.numerics = append(c.numerics, field.numeric)
+			c.exists = append(c.exists, true)
+		case DocValuesBinary:
+			c.binaries = append(c.binaries, field.binary)
+			c.exists = append(c.exists, true)
+		default:
+			values[field.name] = append(values[field.name], field.value)
+		}
+	}
+	for name, vs := range values {
+		c := columns[name]
+		sort.Strings(vs)
+		for i, v := range vs {
+			if i > 0 && v == vs[i-1] {
+				continue
+			}
+			c.ords = append(c.ords, int64(len(c.terms)))
+			c.terms = append(c.terms, v)
+		}
+		c.starts = append(c.starts, int64(len(c.ords)))
+	}
+	return columns, nil
+}
+
+// mergeDocValues one column of the documents of columns in turn,
+// a nil column stands for sizes[i] documents without a value
+func mergeDocValues(typ DocValuesType, columns []*docValuesColumn, sizes []int64) *docValuesColumn {
+	merged := newDocValuesColumn(typ)
+
+	// sorted distinct values of all columns
+	if typ == DocValuesSorted || typ == DocValuesSortedSet {
+		seen := map[string]bool{}
+		for _, c := range columns {
+			if c == nil {
+				continue
+			}
+			for _, term := range c.terms {
+				if !seen[term] {
+					seen[term] = true
+					merged.terms = append(merged.terms, term)
+				}
+			}
+		}
+		sort.Strings(merged.terms)
+	}
+
+	for i, c := range columns {
+		if c == nil {
+			merged.addMissing(sizes[i])
+			continue
+		}
+		switch typ {
+		case DocValuesNumeric:
+			merged.numerics = append(merged.numerics, c.numerics...)
+			merged.exists = append(merged.exists, c.exists...)
+		case DocValuesBinary:
+			merged.binaries = append(merged.binaries, c.binaries...)
+			merged.exists = append(merged.exists, c.exists...)
+		default:
+			ordMap := make([]int64, len(c.terms)) // ordinal of the column to merged ordinal
+			for ord, term := range c.terms {
+				ordMap[ord] = int64(sort.SearchStrings(merged.terms, term))
+			}
+			for doc := int64(0); doc < c.size(); doc++ {
+				for _, ord := range c.docOrds(doc) {
+					merged.ords = append(merged.ords, ordMap[ord])
+				}
+				merged.starts = append(merged.starts, int64(len(merged.ords)))
+			}
+		}
+	}
+	return merged
+}
+
+// docValuesPath dv file of field number n
+func docValuesPath(dirPath string, segment string, n int64) string {
+	return path.Join(dirPath, segment+FileSuffix["docValues"]+strconv.FormatInt(n, 10))
+}
+
+// writeDocValues write a column to its file
+func writeDocValues(filePath string, c *docValuesColumn) error {
+	f, err := CreateFile(filePath, false, false)
+	if err != nil {
+		return err
+	}
+	err = c.write(f)
+	if e := f.close(); err == nil {
+		err = e
+	}
+	return err
+}
+
+// write write the column
+func (c *docValuesColumn) write(f *File) error {
+	err := f.writeByte(byte(c.typ))
+	if err != nil {
+		return err
+	}
+	size := c.size()
+	err = f.writeVarInt64(size)
+	if err != nil {
+		return err
+	}
+
+	switch c.typ {
+	case DocValuesNumeric, DocValuesBinary:
+		for doc := int64(0); doc < size; doc++ {
+			var exists byte
+			if c.exists[doc] {
+				exists = 1
+			}
+			err = f.writeByte(exists)
+			if err != nil {
+				return err
+			}
+			if c.typ == DocValuesNumeric {
+				err = f.writeInt64(c.numerics[doc])
+			} else if c.exists[doc] {
+				err = f.writeBytes(c.binaries[doc])
+			}
+			if err != nil {
+				return err
+			}
+		}
+	default:
+		err = f.writeVarInt(len(c.terms))
+		if err != nil {
+			return err
+		}
+		for _, term := range c.terms {
+			err = f.writeString(term)
+			if err != nil {
+				return err
+			}
+		}
+		for doc := int64(0); doc < size; doc++ {
+			ords := c.docOrds(doc)
+			err = f.writeVarInt(len(ords))
+			if err != nil {
+				return err
+			}
+			lastOrd := int64(0)
+			for _, ord := range ords {
+				err = f.writeVarInt64(ord - lastOrd)
+				if err != nil {
+					return err
+				}
+				lastOrd = ord
+			}
+		}
+	}
+	return nil
+}
+
+// readDocValues read a column from its file, nil when the segment has no values of the field
+func readDocValues(filePath string) (*docValuesColumn, error) {
+	if _, err := os.Stat(filePath); os.IsNotExist(err) {
+		return nil, nil
+	}
+	f, err := CreateFile(filePath, false, true)
+	if err != nil {
+		return nil, err
+	}
+	defer f.close()
+
+	typ, err := f.readByte()
+	if err != nil {
+		return nil, err
+	}
+	c := newDocValuesColumn(DocValuesType(typ))
+	size, err := f.readVarInt64()
+	if err != nil {
+		return nil, err
+	}
+
+	switch c.typ {
+	case DocValuesNumeric, DocValuesBinary:
+		for doc := int64(0); doc < size; doc++ {
+			exists, err := f.readByte()
+			if err != nil {
+				return nil, err
+			}
+			c.exists = append(c.exists, exists == 1)
+			if c.typ == DocValuesNumeric {
+				n, err := f.readInt64()
+				if err != nil {
+					return nil, err
+				}
+				c.numerics = append(c.numerics, n)
+				continue
+			}
+			var b []byte
+			if exists == 1 {
+				b, err = f.readBytes()
+				if err != nil {
+					return nil, err
+				}
+			}
+			c.binaries = append(c.binaries, b)
+		}
+	case DocValuesSorted, DocValuesSortedSet:
+		n, err := f.readVarInt()
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < n; i++ {
+			term, err := f.readString()
+			if err != nil {
+				return nil, err
+			}
+			c.terms = append(c.terms, term)
+		}
+		for doc := int64(0); doc < size; doc++ {
+			count, err := f.readVarInt()
+			if err != nil {
+				return nil, err
+			}
+			lastOrd := int64(0)
+			for i := 0; i < count; i++ {
+				delta, err := f.readVarInt64()
+				if err != nil {
+					return nil, err
+				}
+				lastOrd = lastOrd + delta
+				c.ords = append(c.ords, lastOrd)
+			}
+			c.starts = append(c.starts, int64(len(c.ords)))
+		}
+	default:
+		return nil, fmt.Errorf("unknown doc values type %d in %s", typ, filePath)
+	}
+	return c, nil
+}
@@ -1,9 +1,13 @@
 package core
 
 import (
+	"fmt"
+	"io"
 	"os"
 	"path"
+	"sort"
 	"strconv"
+	"strings"
 )
 
 // DocumentWriter document writer
@@ -12,6 +16,7 @@ type DocumentWriter struct {
 	// Directory      Directory
 	fieldInfos     *FieldInfos
 	maxFieldLength int64
+	config         *IndexWriterConfig // settings of the written segment
 	dirPath        string
 	postingTable   map[Term]Posting
 	fieldLengths   []int64
@@ -22,23 +27,45 @@ func (dw *DocumentWriter) Init(dirPath string, analyzer Analyzer, mfl int64) err
 	dw.dirPath = dirPath
 	dw.analyzer = analyzer
 	dw.maxFieldLength = mfl
+	dw.config = NewIndexWriterConfig(analyzer)
+	dw.config.MaxFieldLength = mfl
 	return nil
 }
 
 // AddDocument add doc
 func (dw *DocumentWriter) AddDocument(segment string, doc Document) (bool, error) {
 
+	var err error
+
 	// (1) add field names
-	dw.addFieldNames(segment, doc)
+	err = dw.addFieldNames(segment, doc)
+	if err != nil {
+		return false, err
+	}
 
 	// (2) add field values
-	dw.addFieldValues(segment, doc)
+	err = dw.addFieldValues(segment, doc)
+	if err != nil {
+		return false, err
+	}
 
 	// (3) add field positions, (frequency and position)
-	dw.addFieldPostings(segment, doc)
+	err = dw.addFieldPostings(segment, doc)
+	if err != nil {
+		return false, err
+	}
 
 	// (4) add norms
-	dw.addFieldNorms(segment, doc)
+	err = dw.addFieldNorms(segment, doc)
+	if err != nil {
+		return false, err
+	}
+
+	// (5) add doc values
+	err = dw.addFieldDocValues(segment, doc)
+	if err != nil {
+		return false, err
+	}
 
 	return true, nil
 }
@@ -50,7 +77,10 @@ func (dw *DocumentWriter) addFieldNames(segment string, doc Document) error {
 	fieldsPtr.empty()
 	fieldsPtr.init()
 
-	fieldsPtr.addDoc(doc)
+	err := fieldsPtr.addDoc(doc)
+	if err != nil {
+		return err
+	}
 
 	dw.fieldInfos = fieldsPtr
 	filePath := path.Join(dw.dirPath, segment+FileSuffix["fieldName"])
@@ -62,9 +92,14 @@ func (dw *DocumentWriter) addFieldNames(segment string, doc Document) error {
 func (dw *DocumentWriter) addFieldValues(segment string, doc Document) error {
 	var err error
 	fw := FieldsWriter{}
-	fw.init(dw.dirPath, segment, dw.fieldInfos)
+	err = fw.init(dw.dirPath, segment, dw.fieldInfos, dw.config)
+	if err != nil {
+		fw.abort()
+		return err
+	}
 	err = fw.addDocument(doc)
 	if err != nil {
+		fw.abort()
 		return err
 	}
 	err = fw.Close() // flush
@@ -78,20 +113,47 @@ func (dw *DocumentWriter) addFieldValues(segment string, doc Document) error {
 func (dw *DocumentWriter) addFieldPostings(segment string, doc Document) error {
 	// invert doc into postingTable
 	dw.postingTable = map[Term]Posting{}
-	dw.invertDocument(doc)
+	err := dw.invertDocument(doc)
+	if err != nil {
+		return err
+	}
 
 	// sort postingTable into an array
 	postings, _ := dw.sortPostingTable()
 
 	// write postings
-	dw.writePostings(postings, segment)
+	err = dw.writePostings(postings, segment)
+	if err != nil {
+		return err
+	}
+
+	// write term vectors
+	if dw.fieldInfos.hasVectors() {
+		return dw.writeVectors(postings, segment)
+	}
 	return nil
 }
 
+// writeVectors write the term vectors of the document
+func (dw *DocumentWriter) writeVectors(postings []Posting, segment string) error {
+	tw := new(TermVectorsWriter)
+	err := tw.init(dw.dirPath, segment, dw.fieldInfos)
+	if err != nil {
+		tw.close()
+		return err
+	}
+	err = tw.addDocument(postingsVectors(postings, dw.fieldInfos))
+	if e := tw.close(); err == nil {
+		err = e
+	}
+	return err
+}
+
 func (dw *DocumentWriter) invertDocument(doc Document) error {
 
 	lenFields := len(dw.fieldInfos.byNumber)
 	dw.fieldLengths = make([]int64, lenFields)
+	inverted := make([]bool, lenFields) // a value of the field was inverted
 
 	for _, field := range doc.Fields {
 		fieldName := field.name
@@ -99,28 +161,85 @@ func (dw *DocumentWriter) invertDocument(doc Document) error {
 		position := dw.fieldLengths[fieldNumber] // position in field
 		fieldValue := field.value
 		if field.isIndexed {
+			if inverted[fieldNumber] && dw.analyzer != nil { // next value of a multi-valued field
+				position = position + positionIncrementGap(dw.analyzer, fieldName)
+			}
+			inverted[fieldNumber] = true
 			if !field.isTokenized { // un-tokenized field
-				dw.addPosition(fieldName, fieldValue, position)
+				dw.addPosition(fieldName, fieldValue, position, 0, int64(len(fieldValue)))
+				position = position + 1
 			} else {
-				// fieldValue := field.Value
+				var reader io.Reader
+				if field.reader != nil { // streamed through the analyzer
+					reader = field.reader
+				} else {
+					reader = strings.NewReader(fieldValue)
+				}
+				var err error
+				position, err = dw.invertField(fieldName, reader, position)
+				if err != nil {
+					return err
+				}
 			}
 		}
-		position = position + 1
 		dw.fieldLengths[fieldNumber] = position
 	}
 	return nil
 }
 
-func (dw *DocumentWriter) addPosition(fieldName string, fieldValue string, position int64) error {
-	// word not seen before
+// invertField analyze a tokenized field value, return the next position
+func (dw *DocumentWriter) invertField(fieldName string, reader io.Reader, position int64) (int64, error) {
+	if dw.analyzer == nil {
+		closeSource(reader)
+		return position, fmt.Errorf("no analyzer for tokenized field %s", fieldName)
+	}
+	stream, err := dw.analyzer.TokenStream(fieldName, reader)
+	if err != nil {
+		closeSource(reader)
+		return position, err
+	}
+	defer stream.Close()
+
+	first := position
+	for {
+		t, err := stream.Next()
+		if err != nil {
+			return position, err
+		}
+		if t == nil {
+			break
+		}
+		position = position + t.PositionIncrement - 1
+		if position < first { // a stacked first token starts the value
+			position = first
+		}
+		dw.addPosition(fieldName, t.TermText, position, t.StartOffset, t.EndOffset)
+		position = position + 1
+		if position > dw.maxFieldLength {
+			break
+		}
+	}
+	return position, nil
+}
+
+func (dw *DocumentWriter) addPosition(fieldName string, fieldValue string, position, start, end int64) error {
 	term := Term{
 		field: fieldName,
 		text:  fieldValue,
 	}
-	posting := Posting{
-		term:      term,
-		freq:      1,
-		positions: []int64{position},
+	offset := TermVectorOffsetInfo{StartOffset: start, EndOffset: end}
+	posting, found := dw.postingTable[term]
+	if found { // word seen before
+		posting.freq = posting.freq + 1
+		posting.positions = append(posting.positions, position)
+		posting.offsets = append(posting.offsets, offset)
+	} else { // word not seen before
+		posting = Posting{
+			term:      term,
+			freq:      1,
+			positions: []int64{position},
+			offsets:   []TermVectorOffsetInfo{offset},
+		}
 	}
 	dw.postingTable[term] = posting
 	return nil
@@ -131,6 +250,9 @@ func (dw *DocumentWriter) sortPostingTable() ([]Posting, error) {
 	for _, v := range dw.postingTable {
 		postings = append(postings, v)
 	}
+	sort.Slice(postings, func(i, j int) bool {
+		return postings[i].term.compare(postings[j].term) < 0
+	})
 	return postings, nil
 }
 
@@ -145,25 +267,32 @@ func (dw *DocumentWriter) writePostings(postings []Posting, segment string) erro
 	if err != nil {
 		return err
 	}
+	defer frqPtr.close()
 
 	filePath = path.Join(dw.dirPath, segment+FileSuffix["termPositions"])
 	prxPtr, err := CreateFile(filePath, false, false)
 	if err != nil {
 		return err
 	}
+	defer prxPtr.close()
 
 	tw := new(TermsWriter)
-	tw.init(dw.dirPath, segment, dw.fieldInfos)
+	err = tw.init(dw.dirPath, segment, dw.fieldInfos, dw.config.IndexInterval)
+	if err != nil {
+		return err
+	}
 	ti := TermInfo{}
 
 	for _, posting := range postings {
 		// init terminfo
 		frqSize, err := frqPtr.getSize()
 		if err != nil {
+			tw.close()
 			return err
 		}
 		prxSize, err := prxPtr.getSize()
 		if err != nil {
+			tw.close()
 			return err
 		}
 
@@ -171,41 +300,28 @@ func (dw *DocumentWriter) writePostings(postings []Posting, segment string) erro
 		ti.Init(1, frqSize, prxSize)
 		err = tw.addTerm(posting.term, ti)
 		if err != nil {
+			tw.close()
 			return err
 		}
 
-		// add an entry to the freq file
-		f := posting.freq
-		if f == 1 { // optimize freq=1
-			frqPtr.writeVarInt(1) // set low bit of doc num.
-		} else {
-			frqPtr.writeVarInt(0)      // the document number
-			frqPtr.writeVarInt(int(f)) // frequency in doc
-		}
-
-		var lastPosition int64 = 0 // write positions
-		positions := posting.positions
-		i := int64(0)
-		for i < posting.freq {
-			position := positions[i]
-			diff := position - lastPosition
-			prxPtr.writeVarInt64(diff)
-			lastPosition = position
-			i = i + 1
+		// add an entry to the freq and prox files, the only document is document 0
+		termDoc := TermDoc{Freq: posting.freq, Positions: posting.positions[:posting.freq]}
+		_, err = writePostings(frqPtr, prxPtr, []TermDoc{termDoc}, 0, 0)
+		if err != nil {
+			tw.close()
+			return err
 		}
 	}
 
-	if frqPtr != nil {
-		frqPtr.flush()
-	}
-	if prxPtr != nil {
-		prxPtr.flush()
+	err = frqPtr.flush()
+	if err == nil {
+		err = prxPtr.flush()
 	}
-
-	if tw != nil {
+	if err != nil {
 		tw.close()
+		return err
 	}
-	return nil
+	return tw.close()
 }
 
 // write frq
@@ -250,22 +366,46 @@ func (dw *DocumentWriter) writeTis(postings []Posting, segment string) error {
 
 // add field norms
 func (dw *DocumentWriter) addFieldNorms(segment string, doc Document) error {
+	written := map[int64]bool{} // one norm for all the values of a field
 	for _, field := range doc.Fields {
 		if field.isIndexed {
 			fieldNumber, err := dw.fieldInfos.getNumber(field.name)
 			if err != nil {
 				return err
 			}
+			if written[fieldNumber] {
+				continue
+			}
+			written[fieldNumber] = true
 			filePath := path.Join(dw.dirPath, segment+FileSuffix["norms"]+strconv.FormatInt(fieldNumber, 10))
 			nPtr, err := CreateFile(filePath, false, false)
 			if err != nil {
 				return err
 			}
 
-			n := SimilarityNorm(dw.fieldLengths[fieldNumber])
+			n := dw.config.Similarity.LengthNorm(field.name, dw.fieldLengths[fieldNumber])
 			nPtr.writeByte(n)
 			nPtr.flush()
 		}
 	}
 	return nil
 }
+
+// add field doc values
+func (dw *DocumentWriter) addFieldDocValues(segment string, doc Document) error {
+	columns, err := docValuesColumns(doc)
+	if err != nil {
+		return err
+	}
+	for fieldName, column := range columns {
+		fieldNumber, err := dw.fieldInfos.getNumber(fieldName)
+		if err != nil {
+			return err
+		}
+		err = writeDocValues(docValuesPath(dw.dirPath, segment, fieldNumber), column)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,453 @@
+package core
+
+import (
+	"path"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+/*
+A DocumentsWriter buffers the added documents in memory, inverted,
+and writes them as a single segment when flushed:
+the postings of each term over the buffered documents, their stored fields, term vectors, norms and doc values.
+
+The writer flushes the buffer once it holds about RAMBufferSize bytes or MaxBufferedDocs documents of its config,
+so a bulk load writes few large segments instead of a segment per document.
+The documents of a block are added together, a flush never falls inside a block.
+
+Each AddDocument running at the same time takes a buffer of its own from the pool of the writer,
+so documents are analyzed in parallel, and the segments of the buffers written in parallel.
+*/
+
+// DocumentsWriter in memory buffer of inverted documents
+type DocumentsWriter struct {
+	mu         sync.Mutex // held by the AddDocument or flush using the buffer
+	config     *IndexWriterConfig
+	fieldInfos *FieldInfos
+	postings   map[Term][]TermDoc            // documents of each term, in document order
+	stored     []Document                    // stored fields of each document
+	vectors    [][]*TermFreqVector           // term vectors of each document
+	norms      map[string][]byte             // norm of each document by field, documents before the field first came are padded
+	docValues  []map[string]*docValuesColumn // doc values of each document
+	numDocs    int64
+	deletes    []bufferedDelete // deletes of the buffered documents, each of those added before it
+	ramBytes   int64            // estimate of the memory used
+}
+
+// documentsWriterPool buffers of a writer, one per concurrent AddDocument
+type documentsWriterPool struct {
+	mu      sync.Mutex
+	config  *IndexWriterConfig
+	buffers []*DocumentsWriter // every buffer
+	free    []*DocumentsWriter // buffers not in use
+}
+
+// invertedDoc a document inverted, before it is added to the buffer
+type invertedDoc struct {
+	fieldInfos *FieldInfos
+	postings   []Posting
+	stored     Document
+	vectors    []*TermFreqVector
+	lengths    map[string]int64 // length of each indexed field
+	docValues  map[string]*docValuesColumn
+	ramBytes   int64
+}
+
+// newDocumentsWriter empty buffer
+func newDocumentsWriter(config *IndexWriterConfig) *DocumentsWriter {
+	dsw := &DocumentsWriter{
+		config: config,
+	}
+	dsw.reset()
+	return dsw
+}
+
+// newDocumentsWriterPool empty pool
+func newDocumentsWriterPool(config *IndexWriterConfig) *documentsWriterPool {
+	return &documentsWriterPool{
+		config: config,
+	}
+}
+
+// ================================documentsWriterPool=======================================
+
+// obtain lock a free buffer, a new one when all are in use
+func (p *documentsWriterPool) obtain() *DocumentsWriter {
+	p.mu.Lock()
+	var dsw *DocumentsWriter
+	if n := len(p.free); n > 0 {
+		dsw = p.free[n-1]
+		p.free = p.free[:n-1]
+	} else {
+		dsw = newDocumentsWriter(p.config)
+		p.buffers = append(p.buffers, dsw)
+	}
+	p.mu.Unlock()
+	dsw.mu.Lock()
+	return dsw
+}
+
+// release unlock a buffer obtained
+func (p *documentsWriterPool) release(dsw *DocumentsWriter) {
+	dsw.mu.Unlock()
+	p.mu.Lock()
+	p.free = append(p.free, dsw)
+	p.mu.Unlock()
+}
+
+// all every buffer of the pool
+func (p *documentsWriterPool) all() []*DocumentsWriter {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	buffers := make([]*DocumentsWriter, len(p.buffers))
+	copy(buffers, p.buffers)
+	return buffers
+}
+
+// lockAll lock every buffer, no buffer is obtained or created until unlockAll
+func (p *documentsWriterPool) lockAll() []*DocumentsWriter {
+	p.mu.Lock()
+	buffers := make([]*DocumentsWriter, len(p.buffers))
+	copy(buffers, p.buffers)
+	for _, dsw := range buffers {
+		dsw.mu.Lock()
+	}
+	return buffers
+}
+
+// unlockAll unlock the buffers of lockAll
+func (p *documentsWriterPool) unlockAll(buffers []*DocumentsWriter) {
+	for _, dsw := range buffers {
+		dsw.mu.Unlock()
+	}
+	p.mu.Unlock()
+}
+
+// ================================DocumentsWriter=======================================
+
+// reset empty the buffer
+func (dsw *DocumentsWriter) reset() {
+	fieldInfos := new(FieldInfos)
+	fieldInfos.empty()
+	fieldInfos.init()
+	dsw.fieldInfos = fieldInfos
+	dsw.postings = map[Term][]TermDoc{}
+	dsw.stored = nil
+	dsw.vectors = nil
+	dsw.norms = map[string][]byte{}
+	dsw.docValues = nil
+	dsw.numDocs = 0
+	dsw.deletes = nil
+	dsw.ramBytes = 0
+}
+
+// NumDocs number of documents buffered
+func (dsw *DocumentsWriter) NumDocs() int64 {
+	return dsw.numDocs
+}
+
+// RAMBytesUsed estimate of the memory used by the buffered documents
+func (dsw *DocumentsWriter) RAMBytesUsed() int64 {
+	return dsw.ramBytes
+}
+
+// addDocuments add documents with consecutive numbers, analyzing text with analyzer, none is added when one of them fails
+func (dsw *DocumentsWriter) addDocuments(docs []Document, analyzer Analyzer) error {
+	inverted := []*invertedDoc{}
+	fieldInfos := new(FieldInfos)
+	fieldInfos.empty()
+	fieldInfos.addFields(dsw.fieldInfos)
+	for _, doc := range docs {
+		id, err := dsw.invert(doc, analyzer)
+		if err != nil {
+			return err
+		}
+		err = fieldInfos.addFields(id.fieldInfos)
+		if err != nil {
+			return err
+		}
+		inverted = append(inverted, id)
+	}
+
+	dsw.fieldInfos = fieldInfos
+	for _, id := range inverted {
+		dsw.add(id)
+	}
+	return nil
+}
+
+// invert analyze a document with analyzer
+func (dsw *DocumentsWriter) invert(doc Document, analyzer Analyzer) (*invertedDoc, error) {
+	fieldInfos := new(FieldInfos)
+	fieldInfos.empty()
+	err := fieldInfos.addDoc(doc)
+	if err != nil {
+		return nil, err
+	}
+	docValues, err := docValuesColumns(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	dw := &DocumentWriter{
+		analyzer:       analyzer,
+		fieldInfos:     fieldInfos,
+		maxFieldLength: dsw.config.MaxFieldLength,
+		config:         dsw.config,
+		postingTable:   map[Term]Posting{},
+	}
+	err = dw.invertDocument(doc)
+	if err != nil {
+		return nil, err
+	}
+	postings, _ := dw.sortPostingTable()
+
+	id := &invertedDoc{
+		fieldInfos: fieldInfos,
+		postings:   postings,
+		lengths:    map[string]int64{},
+		docValues:  docValues,
+	}
+	for _, field := range doc.Fields {
+		if field.isStored {
+			id.stored.Add(field)
+			id.ramBytes = id.ramBytes + int64(len(field.name)+len(field.value)+len(field.binary)) + 64
+		}
+		if field.isIndexed {
+			fieldNumber, _ := fieldInfos.getNumber(field.name)
+			id.lengths[field.name] = dw.fieldLengths[fieldNumber]
+		}
+		if field.docValuesType != DocValuesNone {
+			id.ramBytes = id.ramBytes + int64(len(field.value)+len(field.binary)) + 16
+		}
+	}
+	if fieldInfos.hasVectors() {
+		id.vectors = postingsVectors(postings, fieldInfos)
+	}
+	for _, posting := range postings {
+		id.ramBytes = id.ramBytes + int64(len(posting.term.text)) + 48 + 8*posting.freq
+		if fi := fieldInfos.byName[posting.term.field]; fi.storeTermVector {
+			id.ramBytes = id.ramBytes + int64(len(posting.term.text)) + 24 + 24*posting.freq
+		}
+	}
+	return id, nil
+}
+
+// add append an inverted document
+func (dsw *DocumentsWriter) add(id *invertedDoc) {
+	doc := dsw.numDocs
+	for _, posting := range id.postings {
+		termDocs, found := dsw.postings[posting.term]
+		if !found {
+			dsw.ramBytes = dsw.ramBytes + int64(len(posting.term.field)+len(posting.term.text)) + 64
+		}
+		dsw.postings[posting.term] = append(termDocs, TermDoc{
+			Doc:       doc,
+			Freq:      posting.freq,
+			Positions: posting.positions,
+		})
+	}
+
+	for fieldName, length := range id.lengths {
+		norms := dsw.norms[fieldName]
+		for int64(len(norms)) < doc {
+			norms = append(norms, 0)
+		}
+		dsw.norms[fieldName] = append(norms, dsw.config.Similarity.LengthNorm(fieldName, length))
+	}
+
+	dsw.stored = append(dsw.stored, id.stored)
+	dsw.vectors = append(dsw.vectors, id.vectors)
+	dsw.docValues = append(dsw.docValues, id.docValues)
+	dsw.ramBytes = dsw.ramBytes + id.ramBytes + int64(len(id.lengths)) + 32
+	dsw.numDocs = dsw.numDocs + 1
+}
+
+// flush write the buffered documents as a segment, and empty the buffer
+func (dsw *DocumentsWriter) flush(dirPath string, segment string) (SegmentInfo, error) {
+	seg := SegmentInfo{
+		name:     segment,
+		docCount: dsw.numDocs,
+		dirPath:  dirPath,
+	}
+
+	err := removeDeletedDocs(dirPath, segment)
+	if err != nil {
+		return seg, err
+	}
+
+	// (1) field names
+	err = dsw.fieldInfos.write(path.Join(dirPath, segment+FileSuffix["fieldName"]))
+	if err != nil {
+		return seg, err
+	}
+
+	// (2) stored fields
+	fw := FieldsWriter{}
+	err = fw.init(dirPath, segment, dsw.fieldInfos, dsw.config)
+	if err != nil {
+		return seg, err
+	}
+	for _, doc := range dsw.stored {
+		err = fw.addDocument(doc)
+		if err != nil {
+			fw.Close()
+			return seg, err
+		}
+	}
+	err = fw.Close()
+	if err != nil {
+		return seg, err
+	}
+
+	// (3) postings
+	err = dsw.writePostings(dirPath, segment)
+	if err != nil {
+		return seg, err
+	}
+
+	// (4) term vectors
+	err = dsw.writeVectors(dirPath, segment)
+	if err != nil {
+		return seg, err
+	}
+
+	// (5) norms
+	err = dsw.writeNorms(dirPath, segment)
+	if err != nil {
+		return seg, err
+	}
+
+	// (6) doc values
+	err = dsw.writeDocValues(dirPath, segment)
+	if err != nil {
+		return seg, err
+	}
+
+	dsw.reset()
+	return seg, nil
+}
+
+// writePostings write the terms in term order with their postings
+func (dsw *DocumentsWriter) writePostings(dirPath string, segment string) error {
+	terms := make([]Term, 0, len(dsw.postings))
+	for term := range dsw.postings {
+		terms = append(terms, term)
+	}
+	sort.Slice(terms, func(i, j int) bool {
+		return terms[i].compare(terms[j]) < 0
+	})
+
+	frqPtr, err := CreateFile(path.Join(dirPath, segment+FileSuffix["termFrequencies"]), false, false)
+	if err != nil {
+		return err
+	}
+	defer frqPtr.close()
+	prxPtr, err := CreateFile(path.Join(dirPath, segment+FileSuffix["termPositions"]), false, false)
+	if err != nil {
+		return err
+	}
+	defer prxPtr.close()
+
+	tw := new(TermsWriter)
+	err = tw.init(dirPath, segment, dsw.fieldInfos, dsw.config.IndexInterval)
+	if err != nil {
+		return err
+	}
+	for _, term := range terms {
+		frqSize, err := frqPtr.getSize()
+		if err != nil {
+			tw.close()
+			return err
+		}
+		prxSize, err := prxPtr.getSize()
+		if err != nil {
+			tw.close()
+			return err
+		}
+		termDocs := dsw.postings[term]
+		_, err = writePostings(frqPtr, prxPtr, termDocs, 0, 0)
+		if err != nil {
+			tw.close()
+			return err
+		}
+
+		ti := TermInfo{}
+		ti.Init(int64(len(termDocs)), frqSize, prxSize)
+		err = tw.addTerm(term, ti)
+		if err != nil {
+			tw.close()
+			return err
+		}
+	}
+	return tw.close()
+}
+
+// writeVectors write the term vectors of every document
+func (dsw *DocumentsWriter) writeVectors(dirPath string, segment string) error {
+	if !dsw.fieldInfos.hasVectors() {
+		return nil
+	}
+	tw := new(TermVectorsWriter)
+	err := tw.init(dirPath, segment, dsw.fieldInfos)
+	if err != nil {
+		tw.close()
+		return err
+	}
+	for _, vectors := range dsw.vectors {
+		err = tw.addDocument(vectors)
+		if err != nil {
+			tw.close()
+			return err
+		}
+	}
+	return tw.close()
+}
+
+// writeNorms write the norms of every indexed field, 0 for the documents without the field
+func (dsw *DocumentsWriter) writeNorms(dirPath string, segment string) error {
+	for _, fi := range dsw.fieldInfos.byNumber {
+		if !fi.isIndexed {
+			continue
+		}
+		norms := dsw.norms[fi.name]
+		for int64(len(norms)) < dsw.numDocs {
+			norms = append(norms, 0)
+		}
+		filePath := path.Join(dirPath, segment+FileSuffix["norms"]+strconv.FormatInt(fi.number, 10))
+		nPtr, err := CreateFile(filePath, false, false)
+		if err != nil {
+			return err
+		}
+		_, err = nPtr.file.Write(norms)
+		if e := nPtr.close(); err == nil {
+			err = e
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeDocValues write the doc values column of every doc values field
+func (dsw *DocumentsWriter) writeDocValues(dirPath string, segment string) error {
+	for _, fi := range dsw.fieldInfos.byNumber {
+		if fi.docValuesType == DocValuesNone {
+			continue
+		}
+		columns := make([]*docValuesColumn, len(dsw.docValues))
+		sizes := make([]int64, len(dsw.docValues))
+		for i, docValues := range dsw.docValues {
+			columns[i] = docValues[fi.name]
+			sizes[i] = 1
+		}
+		column := mergeDocValues(fi.docValuesType, columns, sizes)
+		err := writeDocValues(docValuesPath(dirPath, segment, fi.number), column)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,625 @@
+package core
+
+import (
+	"strings"
+)
+
+/*
+DoubleMetaphone is Lawrence Philips' double metaphone code.
+It knows about many more spellings than metaphone, germanic, slavic, italian, spanish,
+chinese romanizations and others,
+and returns an alternate code when a name may be pronounced two ways,
+e.g. "Zhang" is JNK, "Schmidt" is XMT or SMT.
+MaxLength limits the length of a code, four when zero.
+*/
+
+// DoubleMetaphone double metaphone encoder
+type DoubleMetaphone struct {
+	MaxLength int
+}
+
+// doubleMetaphone state of encoding one word
+type doubleMetaphone struct {
+	value         []rune // upper case word
+	primary       []rune
+	alternate     []rune
+	maxLength     int
+	slavoGermanic bool // w, k, cz or witz
+}
+
+// ================================DoubleMetaphone=======================================
+
+// Encode primary code, and the alternate code when it differs
+func (dm DoubleMetaphone) Encode(word string) []string {
+	maxLength := dm.MaxLength
+	if maxLength <= 0 {
+		maxLength = 4
+	}
+	value := strings.ToUpper(strings.TrimSpace(word))
+	if !strings.ContainsAny(value, "ABCDEFGHIJKLMNOPQRSTUVWXYZÇÑ") {
+		return nil
+	}
+
+	m := &doubleMetaphone{
+		value:     []rune(value),
+		maxLength: maxLength,
+		slavoGermanic: strings.Contains(value, "W") || strings.Contains(value, "K") ||
+			strings.Contains(value, "CZ") || strings.Contains(value, "WITZ"),
+	}
+	m.encode()
+
+	primary := string(m.primary)
+	alternate := string(m.alternate)
+	if primary == "" {
+		return nil
+	}
+	if alternate == primary || alternate == "" {
+		return []string{primary}
+	}
+	return []string{primary, alternate}
+}
+
+// ================================doubleMetaphone=======================================
+
+// encode encode the word
+func (m *doubleMetaphone) encode() {
+	index := 0
+	for _, start := range []string{"GN", "KN", "PN", "WR", "PS"} {
+		if m.contains(0, start) {
+			index = 1
+		}
+	}
+
+	for !m.complete() && index < len(m.value) {
+		switch m.at(index) {
+		case 'A', 'E', 'I', 'O', 'U', 'Y':
+			if index == 0 {
+				m.add("A")
+			}
+			index = index + 1
+		case 'B':
+			m.add("P")
+			index = m.skip(index, "B")
+		case 'Ç':
+			m.add("S")
+			index = index + 1
+		case 'C':
+			index = m.handleC(index)
+		case 'D':
+			index = m.handleD(index)
+		case 'F':
+			m.add("F")
+			index = m.skip(index, "F")
+		case 'G':
+			index = m.handleG(index)
+		case 'H':
+			index = m.handleH(index)
+		case 'J':
+			index = m.handleJ(index)
+		case 'K':
+			m.add("K")
+			index = m.skip(index, "K")
+		case 'L':
+			index = m.handleL(index)
+		case 'M':
+			m.add("M")
+			if m.conditionM0(index) {
+				index = index + 2
+			} else {
+				index = index + 1
+			}
+		case 'N':
+			m.add("N")
+			index = m.skip(index, "N")
+		case 'Ñ':
+			m.add("N")
+			index = index + 1
+		case 'P':
+			index = m.handleP(index)
+		case 'Q':
+			m.add("K")
+			index = m.skip(index, "Q")
+		case 'R':
+			index = m.handleR(index)
+		case 'S':
+			index = m.handleS(index)
+		case 'T':
+			index = m.handleT(index)
+		case 'V':
+			m.add("F")
+			index = m.skip(index, "V")
+		case 'W':
+			index = m.handleW(index)
+		case 'X':
+			index = m.handleX(index)
+		case 'Z':
+			index = m.handleZ(index)
+		default:
+			index = index + 1
+		}
+	}
+}
+
+// at rune at index, zero outside of the word
+func (m *doubleMetaphone) at(index int) rune {
+	if index < 0 || index >= len(m.value) {
+		return 0
+	}
+	return m.value[index]
+}
+
+// contains the word has one of the candidates, all of the same length, at start
+func (m *doubleMetaphone) contains(start int, candidates ...string) bool {
+	length := len([]rune(candidates[0]))
+	if start < 0 || start+length > len(m.value) {
+		return false
+	}
+	target := string(m.value[start : start+length])
+	for _, c := range candidates {
+		if c == target {
+			return true
+		}
+	}
+	return false
+}
+
+// isVowel vowel, y included
+func (m *doubleMetaphone) isVowel(index int) bool {
+	r := m.at(index)
+	return r != 0 && strings.ContainsRune("AEIOUY", r)
+}
+
+// isGermanic begins like a germanic name
+func (m *doubleMetaphone) isGermanic() bool {
+	return m.contains(0, "VAN ", "VON ") || m.contains(0, "SCH")
+}
+
+// complete both codes are long enough
+func (m *doubleMetaphone) complete() bool {
+	return len(m.primary) >= m.maxLength && len(m.alternate) >= m.maxLength
+}
+
+// skip index after the rune at index and a following repeat
+func (m *doubleMetaphone) skip(index int, repeats ...string) int {
+	if m.contains(index+1, repeats...) {
+		return index + 2
+	}
+	return index + 1
+}
+
+// add add the same code to both codes
+func (m *doubleMetaphone) add(code string) {
+	m.addPrimary(code)
+	m.addAlternate(code)
+}
+
+// addBoth add a different code to the primary and the alternate code
+func (m *doubleMetaphone) addBoth(primary string, alternate string) {
+	m.addPrimary(primary)
+	m.addAlternate(alternate)
+}
+
+// addPrimary add to the primary code, up to the max length
+func (m *doubleMetaphone) addPrimary(code string) {
+	for _, r := range code {
+		if len(m.primary) < m.maxLength {
+			m.primary = append(m.primary, r)
+		}
+	}
+}
+
+// addAlternate add to the alternate code, up to the max length
+func (m *doubleMetaphone) addAlternate(code string) {
+	for _, r := range code {
+		if len(m.alternate) < m.maxLength {
+			m.alternate = append(m.alternate, r)
+		}
+	}
+}
+
+// handleC c, ch, cc, cz and others
+func (m *doubleMetaphone) handleC(index int) int {
+	switch {
+	case m.conditionC0(index): // germanic "bacher", "macher"
+		m.add("K")
+		return index + 2
+	case index == 0 && m.contains(index, "CAESAR"):
+		m.add("S")
+		return index + 2
+	case m.contains(index, "CH"):
+		return m.handleCH(index)
+	case m.contains(index, "CZ") && !m.contains(index-2, "WICZ"): // "czerny"
+		m.addBoth("S", "X")
+		return index + 2
+	case m.contains(index+1, "CIA"): // "focaccia"
+		m.add("X")
+		return index + 3
+	case m.contains(index, "CC") && !(index == 1 && m.at(0) == 'M'): // not "mcclelland"
+		return m.handleCC(index)
+	case m.contains(index, "CK", "CG", "CQ"):
+		m.add("K")
+		return index + 2
+	case m.contains(index, "CI", "CE", "CY"): // italian vs. english
+		if m.contains(index, "CIO", "CIE", "CIA") {
+			m.addBoth("S", "X")
+		} else {
+			m.add("S")
+		}
+		return index + 2
+	}
+
+	m.add("K")
+	switch {
+	case m.contains(index+1, " C", " Q", " G"): // "mac caffrey", "mac gregor"
+		return index + 3
+	case m.contains(index+1, "C", "K", "Q") && !m.contains(index+1, "CE", "CI"):
+		return index + 2
+	}
+	return index + 1
+}
+
+// conditionC0 ch sounding k after a consonant and a, but not before i or e
+func (m *doubleMetaphone) conditionC0(index int) bool {
+	if m.contains(index, "CHIA") {
+		return true
+	}
+	if index <= 1 || m.isVowel(index-2) || !m.contains(index-1, "ACH") {
+		return false
+	}
+	c := m.at(index + 2)
+	return c != 'I' && c != 'E' || m.contains(index-2, "BACHER", "MACHER")
+}
+
+// handleCH ch
+func (m *doubleMetaphone) handleCH(index int) int {
+	switch {
+	case index > 0 && m.contains(index, "CHAE"): // "michael"
+		m.addBoth("K", "X")
+	case m.conditionCH0(index): // greek roots, "chemistry", "chorus"
+		m.add("K")
+	case m.conditionCH1(index): // germanic, greek, or otherwise ch for kh
+		m.add("K")
+	case index > 0:
+		if m.contains(0, "MC") {
+			m.add("K")
+		} else {
+			m.addBoth("X", "K")
+		}
+	default:
+		m.add("X")
+	}
+	return index + 2
+}
+
+// conditionCH0 greek ch at the start
+func (m *doubleMetaphone) conditionCH0(index int) bool {
+	if index != 0 {
+		return false
+	}
+	if !m.contains(index+1, "HARAC", "HARIS") && !m.contains(index+1, "HOR", "HYM", "HIA", "HEM") {
+		return false
+	}
+	return !m.contains(0, "CHORE")
+}
+
+// conditionCH1 ch sounding k
+func (m *doubleMetaphone) conditionCH1(index int) bool {
+	return m.isGermanic() ||
+		m.contains(index-2, "ORCHES", "ARCHIT", "ORCHID") ||
+		m.contains(index+2, "T", "S") ||
+		(m.contains(index-1, "A", "O", "U", "E") || index == 0) &&
+			(m.contains(index+2, "L", "R", "N", "M", "B", "H", "F", "V", "W", " ") || index+1 == len(m.value)-1)
+}
+
+// handleCC cc
+func (m *doubleMetaphone) handleCC(index int) int {
+	if m.contains(index+2, "I", "E", "H") && !m.contains(index+2, "HU") { // "bellocchio" but not "bacchus"
+		if index == 1 && m.at(index-1) == 'A' || m.contains(index-1, "UCCEE", "UCCES") { // "accident", "succeed"
+			m.add("KS")
+		} else { // "bacci", "bertucci"
+			m.add("X")
+		}
+		return index + 3
+	}
+	m.add("K") // pierce's rule
+	return index + 2
+}
+
+// handleD d, dg, dt, dd
+func (m *doubleMetaphone) handleD(index int) int {
+	switch {
+	case m.contains(index, "DG"):
+		if m.contains(index+2, "I", "E", "Y") { // "edge"
+			m.add("J")
+			return index + 3
+		}
+		m.add("TK") // "edgar"
+		return index + 2
+	case m.contains(index, "DT", "DD"):
+		m.add("T")
+		return index + 2
+	}
+	m.add("T")
+	return index + 1
+}
+
+// handleG g, gh, gn and others
+func (m *doubleMetaphone) handleG(index int) int {
+	switch {
+	case m.at(index+1) == 'H':
+		return m.handleGH(index)
+	case m.at(index+1) == 'N':
+		if index == 1 && m.isVowel(0) && !m.slavoGermanic {
+			m.addBoth("KN", "N")
+		} else if !m.contains(index+2, "EY") && m.at(index+1) != 'Y' && !m.slavoGermanic {
+			m.addBoth("N", "KN")
+		} else {
+			m.add("KN")
+		}
+		return index + 2
+	case m.contains(index+1, "LI") && !m.slavoGermanic:
+		m.addBoth("KL", "L")
+		return index + 2
+	case index == 0 && (m.at(index+1) == 'Y' ||
+		m.contains(index+1, "ES", "EP", "EB", "EL", "EY", "IB", "IL", "IN", "IE", "EI", "ER")):
+		m.addBoth("K", "J") // -ges-, -gep-, -gel-, -gie- at the start
+		return index + 2
+	case (m.contains(index+1, "ER") || m.at(index+1) == 'Y') &&
+		!m.contains(0, "DANGER", "RANGER", "MANGER") &&
+		!m.contains(index-1, "E", "I") &&
+		!m.contains(index-1, "RGY", "OGY"):
+		m.addBoth("K", "J") // -ger-, -gy-
+		return index + 2
+	case m.contains(index+1, "E", "I", "Y") || m.contains(index-1, "AGGI", "OGGI"): // italian "biaggi"
+		if m.isGermanic() || m.contains(index+1, "ET") {
+			m.add("K")
+		} else if m.contains(index+1, "IER") {
+			m.add("J")
+		} else {
+			m.addBoth("J", "K")
+		}
+		return index + 2
+	case m.at(index+1) == 'G':
+		m.add("K")
+		return index + 2
+	}
+	m.add("K")
+	return index + 1
+}
+
+// handleGH gh
+func (m *doubleMetaphone) handleGH(index int) int {
+	switch {
+	case index > 0 && !m.isVowel(index-1):
+		m.add("K")
+	case index == 0:
+		if m.at(index+2) == 'I' {
+			m.add("J")
+		} else {
+			m.add("K")
+		}
+	case index > 1 && m.contains(index-2, "B", "H", "D") ||
+		index > 2 && m.contains(index-3, "B", "H", "D") ||
+		index > 3 && m.contains(index-4, "B", "H"):
+		// parker's rule, "hugh"
+	case index > 2 && m.at(index-1) == 'U' && m.contains(index-3, "C", "G", "L", "R", "T"):
+		m.add("F") // "laugh", "cough", "rough", "tough"
+	case m.at(index-1) != 'I':
+		m.add("K")
+	}
+	return index + 2
+}
+
+// handleH h only at the start before a vowel or between vowels
+func (m *doubleMetaphone) handleH(index int) int {
+	if (index == 0 || m.isVowel(index-1)) && m.isVowel(index+1) {
+		m.add("H")
+		return index + 2
+	}
+	return index + 1
+}
+
+// handleJ j, spanish or english
+func (m *doubleMetaphone) handleJ(index int) int {
+	if m.contains(index, "JOSE") || m.contains(0, "SAN ") { // "jose", "san jacinto"
+		if index == 0 && m.at(index+4) == ' ' || len(m.value) == 4 || m.contains(0, "SAN ") {
+			m.add("H")
+		} else {
+			m.addBoth("J", "H")
+		}
+		return index + 1
+	}
+
+	switch {
+	case index == 0:
+		m.addBoth("J", "A") // "yankelovich", "jankelowicz"
+	case m.isVowel(index-1) && !m.slavoGermanic && (m.at(index+1) == 'A' || m.at(index+1) == 'O'):
+		m.addBoth("J", "H") // spanish pronunciation, "bajador"
+	case index == len(m.value)-1:
+		m.addPrimary("J")
+	case !m.contains(index+1, "L", "T", "K", "S", "N", "M", "B", "Z") && !m.contains(index-1, "S", "K", "L"):
+		m.add("J")
+	}
+	return m.skip(index, "J")
+}
+
+// handleL l, spanish ll
+func (m *doubleMetaphone) handleL(index int) int {
+	if m.at(index+1) != 'L' {
+		m.add("L")
+		return index + 1
+	}
+	if m.conditionL0(index) { // "cabrillo", "gallegos"
+		m.addPrimary("L")
+	} else {
+		m.add("L")
+	}
+	return index + 2
+}
+
+// conditionL0 spanish ll
+func (m *doubleMetaphone) conditionL0(index int) bool {
+	last := len(m.value) - 1
+	if index == last-2 && m.contains(index-1, "ILLO", "ILLA", "ALLE") {
+		return true
+	}
+	return (m.contains(last-1, "AS", "OS") || m.contains(last, "A", "O")) && m.contains(index-1, "ALLE")
+}
+
+// conditionM0 mm, or b silent in -umb
+func (m *doubleMetaphone) conditionM0(index int) bool {
+	if m.at(index+1) == 'M' {
+		return true
+	}
+	return m.contains(index-1, "UMB") && (index+1 == len(m.value)-1 || m.contains(index+2, "ER"))
+}
+
+// handleP p, ph
+func (m *doubleMetaphone) handleP(index int) int {
+	if m.at(index+1) == 'H' {
+		m.add("F")
+		return index + 2
+	}
+	m.add("P")
+	return m.skip(index, "P", "B")
+}
+
+// handleR r, silent in french -ier
+func (m *doubleMetaphone) handleR(index int) int {
+	if index == len(m.value)-1 && !m.slavoGermanic && m.contains(index-2, "IE") && !m.contains(index-4, "ME", "MA") {
+		m.addAlternate("R")
+	} else {
+		m.add("R")
+	}
+	return m.skip(index, "R")
+}
+
+// handleS s, sh, sc, sio and others
+func (m *doubleMetaphone) handleS(index int) int {
+	switch {
+	case m.contains(index-1, "ISL", "YSL"): // "island", "carlisle"
+		return index + 1
+	case index == 0 && m.contains(index, "SUGAR"):
+		m.addBoth("X", "S")
+		return index + 1
+	case m.contains(index, "SH"):
+		if m.contains(index+1, "HEIM", "HOEK", "HOLM", "HOLZ") { // germanic
+			m.add("S")
+		} else {
+			m.add("X")
+		}
+		return index + 2
+	case m.contains(index, "SIO", "SIA") || m.contains(index, "SIAN"): // italian and armenian
+		if m.slavoGermanic {
+			m.add("S")
+		} else {
+			m.addBoth("S", "X")
+		}
+		return index + 3
+	case index == 0 && m.contains(index+1, "M", "N", "L", "W") || m.contains(index+1, "Z"):
+		// "smith" matches "schmidt", "snider" matches "schneider", slavic -sz-
+		m.addBoth("S", "X")
+		return m.skip(index, "Z")
+	case m.contains(index, "SC"):
+		return m.handleSC(index)
+	}
+
+	if index == len(m.value)-1 && m.contains(index-2, "AI", "OI") { // french "resnais", "artois"
+		m.addAlternate("S")
+	} else {
+		m.add("S")
+	}
+	return m.skip(index, "S", "Z")
+}
+
+// handleSC sc, sch
+func (m *doubleMetaphone) handleSC(index int) int {
+	switch {
+	case m.at(index+2) == 'H': // schlesinger's rule
+		if m.contains(index+3, "OO", "ER", "EN", "UY", "ED", "EM") { // dutch, "school", "schooner"
+			if m.contains(index+3, "ER", "EN") { // "schermerhorn", "schenker"
+				m.addBoth("X", "SK")
+			} else {
+				m.add("SK")
+			}
+		} else if index == 0 && !m.isVowel(3) && m.at(3) != 'W' {
+			m.addBoth("X", "S")
+		} else {
+			m.add("X")
+		}
+	case m.contains(index+2, "I", "E", "Y"):
+		m.add("S")
+	default:
+		m.add("SK")
+	}
+	return index + 3
+}
+
+// handleT t, th, tion and others
+func (m *doubleMetaphone) handleT(index int) int {
+	switch {
+	case m.contains(index, "TION"), m.contains(index, "TIA", "TCH"):
+		m.add("X")
+		return index + 3
+	case m.contains(index, "TH") || m.contains(index, "TTH"):
+		if m.contains(index+2, "OM", "AM") || m.isGermanic() { // "thomas", "thames"
+			m.add("T")
+		} else {
+			m.addBoth("0", "T")
+		}
+		return index + 2
+	}
+	m.add("T")
+	return m.skip(index, "T", "D")
+}
+
+// handleW w, wr, polish -wicz
+func (m *doubleMetaphone) handleW(index int) int {
+	switch {
+	case m.contains(index, "WR"):
+		m.add("R")
+		return index + 2
+	case index == 0 && (m.isVowel(index+1) || m.contains(index, "WH")):
+		if m.isVowel(index + 1) { // "wasserman" matches "vasserman"
+			m.addBoth("A", "F")
+		} else { // "uomo" matches "womo"
+			m.add("A")
+		}
+		return index + 1
+	case index == len(m.value)-1 && m.isVowel(index-1) ||
+		m.contains(index-1, "EWSKI", "EWSKY", "OWSKI", "OWSKY") ||
+		m.contains(0, "SCH"):
+		m.addAlternate("F") // "arnow" matches "arnoff"
+		return index + 1
+	case m.contains(index, "WICZ", "WITZ"): // polish "filipowicz"
+		m.addBoth("TS", "FX")
+		return index + 4
+	}
+	return index + 1
+}
+
+// handleX x, silent in french -eaux
+func (m *doubleMetaphone) handleX(index int) int {
+	if index == 0 {
+		m.add("S")
+		return index + 1
+	}
+	if !(index == len(m.value)-1 && (m.contains(index-3, "IAU", "EAU") || m.contains(index-2, "AU", "OU"))) {
+		m.add("KS")
+	}
+	return m.skip(index, "C", "X")
+}
+
+// handleZ z, pinyin zh
+func (m *doubleMetaphone) handleZ(index int) int {
+	if m.at(index+1) == 'H' { // pinyin "zhao", "zhang"
+		m.add("J")
+		return index + 2
+	}
+	if m.contains(index+1, "ZO", "ZI", "ZA") || m.slavoGermanic && index > 0 && m.at(index-1) != 'T' {
+		m.addBoth("S", "TS")
+	} else {
+		m.add("S")
+	}
+	return m.skip(index, "Z")
+}
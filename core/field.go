@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"io"
 	"strings"
 )
 
@@ -19,16 +20,49 @@ so that they may be returned with hits on the document.
 type Field struct {
 	name        string
 	value       string
+	reader      io.Reader // value read while indexing, never stored
+	binary      []byte    // stored bytes, never indexed
 	isStored    bool
 	isIndexed   bool
 	isTokenized bool
+	termVector  TermVector // what of the field's terms is kept per document
+
+	docValuesType DocValuesType // column the value is kept in, never stored or indexed
+	numeric       int64         // value of a numeric doc values field
 }
 
+/*
+A TermVector option tells whether the terms of an indexed field are also kept per document,
+with their frequency and optionally their positions and character offsets,
+for highlighting, more-like-this or keyword extraction of a single document.
+*/
+
+// TermVector term vector option of a field
+type TermVector int
+
+const (
+	// TermVectorNo no term vector
+	TermVectorNo TermVector = iota
+	// TermVectorYes terms and frequencies
+	TermVectorYes
+	// TermVectorWithPositions terms, frequencies and positions
+	TermVectorWithPositions
+	// TermVectorWithOffsets terms, frequencies and offsets
+	TermVectorWithOffsets
+	// TermVectorWithPositionsOffsets terms, frequencies, positions and offsets
+	TermVectorWithPositionsOffsets
+)
+
 // FieldInfo field info
 type FieldInfo struct {
-	name      string
-	isIndexed bool
-	number    int64
+	name            string
+	isIndexed       bool
+	number          int64
+	storeTermVector bool
+	storePositions  bool // with the term vector
+	storeOffsets    bool // with the term vector
+	docValuesType   DocValuesType
+	untokenized     bool // some document indexes the field without tokenizing it
 }
 
 // FieldInfos field infos
@@ -64,9 +98,10 @@ type TermInfo struct {
 // Posting posting
 // info about a Term in a doc
 type Posting struct {
-	term      Term    // the Term
-	freq      int64   // its frequency in doc
-	positions []int64 // positions it occurs at
+	term      Term                   // the Term
+	freq      int64                  // its frequency in doc
+	positions []int64                // positions it occurs at
+	offsets   []TermVectorOffsetInfo // offsets it occurs at
 }
 
 // Keyword keyword type field
@@ -81,18 +116,234 @@ func Keyword(name string, value string) (Field, error) {
 	return f, nil
 }
 
+// UnIndexed stored but not indexed field
+func UnIndexed(name string, value string) (Field, error) {
+	f := Field{
+		name:        name,
+		value:       value,
+		isStored:    true,
+		isIndexed:   false,
+		isTokenized: false,
+	}
+	return f, nil
+}
+
+// Text stored, indexed and tokenized field
+func Text(name string, value string) (Field, error) {
+	f := Field{
+		name:        name,
+		value:       value,
+		isStored:    true,
+		isIndexed:   true,
+		isTokenized: true,
+	}
+	return f, nil
+}
+
+// UnStored indexed and tokenized but not stored field
+func UnStored(name string, value string) (Field, error) {
+	f := Field{
+		name:        name,
+		value:       value,
+		isStored:    false,
+		isIndexed:   true,
+		isTokenized: true,
+	}
+	return f, nil
+}
+
+/*
+TextReader is a tokenized and indexed field whose value is read from reader while the document is indexed,
+so a large value is never held in memory as a whole.
+It is not stored, the reader is closed once indexed if it is an io.Closer.
+*/
+
+// TextReader indexed and tokenized field read from a reader
+func TextReader(name string, reader io.Reader) (Field, error) {
+	if reader == nil {
+		return Field{}, fmt.Errorf("nil reader for field %s", name)
+	}
+	f := Field{
+		name:        name,
+		reader:      reader,
+		isStored:    false,
+		isIndexed:   true,
+		isTokenized: true,
+	}
+	return f, nil
+}
+
+// Binary stored but not indexed field of bytes, like a thumbnail or serialized metadata
+func Binary(name string, value []byte) (Field, error) {
+	if value == nil {
+		value = []byte{}
+	}
+	f := Field{
+		name:        name,
+		binary:      value,
+		isStored:    true,
+		isIndexed:   false,
+		isTokenized: false,
+	}
+	return f, nil
+}
+
+/*
+Doc values fields are kept column-wise per segment for sorting, faceting and scripting,
+they are neither stored nor indexed, a Keyword of the same name makes the value searchable.
+A document has at most one NumericDocValuesField, SortedDocValuesField or BinaryDocValuesField of a name,
+and any number of SortedSetDocValuesField fields of a name.
+*/
+
+// NumericDocValuesField int64 doc values field
+func NumericDocValuesField(name string, value int64) (Field, error) {
+	f := Field{
+		name:          name,
+		numeric:       value,
+		docValuesType: DocValuesNumeric,
+	}
+	return f, nil
+}
+
+// SortedDocValuesField string doc values field, kept by ordinal
+func SortedDocValuesField(name string, value string) (Field, error) {
+	f := Field{
+		name:          name,
+		value:         value,
+		docValuesType: DocValuesSorted,
+	}
+	return f, nil
+}
+
+// SortedSetDocValuesField one of the string doc values of a field, kept by ordinal
+func SortedSetDocValuesField(name string, value string) (Field, error) {
+	f := Field{
+		name:          name,
+		value:         value,
+		docValuesType: DocValuesSortedSet,
+	}
+	return f, nil
+}
+
+// BinaryDocValuesField bytes doc values field
+func BinaryDocValuesField(name string, value []byte) (Field, error) {
+	if value == nil {
+		value = []byte{}
+	}
+	f := Field{
+		name:          name,
+		docValuesType: DocValuesBinary,
+	}
+	f.binary = value
+	return f, nil
+}
+
+// ================================Field=======================================
+
+// Name name of the field
+func (f *Field) Name() string {
+	return f.name
+}
+
+// StringValue value of the field, empty for a reader field
+func (f *Field) StringValue() string {
+	return f.value
+}
+
+// BinaryValue bytes of the field, nil for a text field
+func (f *Field) BinaryValue() []byte {
+	return f.binary
+}
+
+// IsBinary the field holds bytes
+func (f *Field) IsBinary() bool {
+	return f.binary != nil
+}
+
+// NumericValue value of a numeric doc values field
+func (f *Field) NumericValue() int64 {
+	return f.numeric
+}
+
+// DocValuesType doc values kind of the field
+func (f *Field) DocValuesType() DocValuesType {
+	return f.docValuesType
+}
+
+// SetTermVector keep the term vector of the field, only an indexed field has one
+func (f *Field) SetTermVector(tv TermVector) error {
+	if tv < TermVectorNo || tv > TermVectorWithPositionsOffsets {
+		return fmt.Errorf("unknown term vector option %d", tv)
+	}
+	if tv != TermVectorNo && !f.isIndexed {
+		return fmt.Errorf("term vector of unindexed field %s", f.name)
+	}
+	f.termVector = tv
+	return nil
+}
+
+// TermVector term vector option of the field
+func (f *Field) TermVector() TermVector {
+	return f.termVector
+}
+
+// ReaderValue reader of the field, nil for a string field
+func (f *Field) ReaderValue() io.Reader {
+	return f.reader
+}
+
 // ================================FieldInfo=======================================
 
-// isIndexByte get field info index info
+// isIndexByte get field info index info,
+// bit 1 indexed, bit 2 term vector, bit 4 vector positions, bit 8 vector offsets,
+// bits 16 to 64 the doc values type, bit 128 untokenized
 func (f *FieldInfo) isIndexByte() byte {
 	var b byte
 	b = 0
 	if f.isIndexed {
-		b = 1
+		b = b | 1
+	}
+	if f.storeTermVector {
+		b = b | 2
+	}
+	if f.storePositions {
+		b = b | 4
+	}
+	if f.storeOffsets {
+		b = b | 8
+	}
+	b = b | byte(f.docValuesType)<<4
+	if f.untokenized {
+		b = b | 128
 	}
 	return b
 }
 
+// setIndexByte set field info from the fnm byte
+func (f *FieldInfo) setIndexByte(b byte) {
+	f.isIndexed = b&1 != 0
+	f.storeTermVector = b&2 != 0
+	f.storePositions = b&4 != 0
+	f.storeOffsets = b&8 != 0
+	f.docValuesType = DocValuesType(b >> 4 & 7)
+	f.untokenized = b&128 != 0
+}
+
+// termVector term vector option of the field info
+func (f *FieldInfo) termVector() TermVector {
+	switch {
+	case !f.storeTermVector:
+		return TermVectorNo
+	case f.storePositions && f.storeOffsets:
+		return TermVectorWithPositionsOffsets
+	case f.storePositions:
+		return TermVectorWithPositions
+	case f.storeOffsets:
+		return TermVectorWithOffsets
+	}
+	return TermVectorYes
+}
+
 // ================================FieldInfos=======================================
 
 // Empty empty byname, bynumber
@@ -126,24 +377,64 @@ func (f *FieldInfos) addField(name string, isIndex bool) error {
 // AddFields add fields
 func (f *FieldInfos) addFields(fs *FieldInfos) error {
 	for _, fi := range fs.byNumber {
-		f.addField(fi.name, fi.isIndexed)
+		err := f.addFieldInfo(fi)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addFieldInfo add a field, or merge its flags into the field of the same name
+func (f *FieldInfos) addFieldInfo(fi FieldInfo) error {
+	old, found := f.byName[fi.name]
+	if !found {
+		fi.number = int64(len(f.byNumber))
+		f.byNumber = append(f.byNumber, fi)
+		f.byName[fi.name] = fi
+		return nil
 	}
+	if old.docValuesType == DocValuesNone {
+		old.docValuesType = fi.docValuesType
+	} else if fi.docValuesType != DocValuesNone && fi.docValuesType != old.docValuesType {
+		return fmt.Errorf("field %s has %s and %s doc values", fi.name, old.docValuesType, fi.docValuesType)
+	}
+	old.isIndexed = old.isIndexed || fi.isIndexed
+	old.storeTermVector = old.storeTermVector || fi.storeTermVector
+	old.storePositions = old.storePositions || fi.storePositions
+	old.storeOffsets = old.storeOffsets || fi.storeOffsets
+	old.untokenized = old.untokenized || fi.untokenized
+	f.byNumber[old.number] = old
+	f.byName[fi.name] = old
 	return nil
 }
 
+// hasVectors some field stores term vectors
+func (f *FieldInfos) hasVectors() bool {
+	for _, fi := range f.byNumber {
+		if fi.storeTermVector {
+			return true
+		}
+	}
+	return false
+}
+
 // AddDoc add doc
 func (f *FieldInfos) addDoc(doc Document) error {
 	fields := doc.Fields
 	for _, field := range fields {
-		fieldName := field.name
-		if _, found := f.byName[fieldName]; !found { // not in byName
-			fi := FieldInfo{
-				name:      fieldName,
-				isIndexed: field.isIndexed,
-				number:    int64(len(f.byNumber)),
-			}
-			f.byNumber = append(f.byNumber, fi)
-			f.byName[fieldName] = fi
+		tv := field.termVector
+		err := f.addFieldInfo(FieldInfo{
+			name:            field.name,
+			isIndexed:       field.isIndexed,
+			storeTermVector: tv != TermVectorNo,
+			storePositions:  tv == TermVectorWithPositions || tv == TermVectorWithPositionsOffsets,
+			storeOffsets:    tv == TermVectorWithOffsets || tv == TermVectorWithPositionsOffsets,
+			docValuesType:   field.docValuesType,
+			untokenized:     field.isIndexed && !field.isTokenized,
+		})
+		if err != nil {
+			return err
 		}
 	}
 	return nil
@@ -176,7 +467,7 @@ func (f *FieldInfos) write(filePath string) error {
 		// (3) write isIndex info
 		fPtr.writeByte(fi.isIndexByte())
 	}
-	return nil
+	return fPtr.close()
 }
 
 // getFieldInfo get field info
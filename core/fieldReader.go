@@ -1,6 +1,12 @@
 package core
 
-import "path"
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"path"
+	"sort"
+)
 
 // FieldsReader fields reader
 type FieldsReader struct {
@@ -8,6 +14,12 @@ type FieldsReader struct {
 	fieldsData  *File
 	fieldsIndex *File
 	size        int64
+	codec       StoredFieldsCodec
+	firstDocs   []int64 // first document of each block
+	pointers    []int64 // fdt pointer of each block
+	block       int     // block decompressed in data, -1 for none
+	data        []byte
+	docStarts   []int // start of each document of the block in data
 }
 
 // TermsReader terms reader
@@ -34,6 +46,7 @@ type SegmentTerms struct {
 // init init reader
 func (fr *FieldsReader) init(dirPath string, segment string, fn *FieldInfos) error {
 	fr.fieldInfos = fn
+	fr.block = -1
 
 	filePath := path.Join(dirPath, segment+FileSuffix["fieldData"])
 
@@ -47,19 +60,103 @@ func (fr *FieldsReader) init(dirPath string, segment string, fn *FieldInfos) err
 	filePath = path.Join(dirPath, segment+FileSuffix["fieldIndex"])
 	fieldsIndex, err := CreateFile(filePath, false, true)
 	if err != nil {
+		fieldsData.close()
 		return err
 	}
+	fr.fieldsIndex = fieldsIndex
 
-	size, err := fieldsIndex.getSize()
+	err = fr.readIndex()
 	if err != nil {
+		fr.close()
 		return err
 	}
+	return nil
 
-	fr.size = size / 8 // get field size
-	fr.fieldsIndex = fieldsIndex
+}
 
-	return nil
+// readIndex read the codec name and the block index
+func (fr *FieldsReader) readIndex() error {
+	name, err := fr.fieldsData.readString()
+	if err != nil {
+		return err
+	}
+	fr.codec, err = storedFieldsCodec(name)
+	if err != nil {
+		return err
+	}
+
+	size, err := fr.fieldsIndex.getSize()
+	if err != nil {
+		return err
+	}
+	if size < 8 || (size-8)%16 != 0 {
+		return fmt.Errorf("fdx of %d bytes", size)
+	}
+	blocks := (size - 8) / 16
+	for i := int64(0); i < blocks; i++ {
+		firstDoc, err := fr.fieldsIndex.readInt64()
+		if err != nil {
+			return err
+		}
+		pointer, err := fr.fieldsIndex.readInt64()
+		if err != nil {
+			return err
+		}
+		fr.firstDocs = append(fr.firstDocs, firstDoc)
+		fr.pointers = append(fr.pointers, pointer)
+	}
+	fr.size, err = fr.fieldsIndex.readInt64() // get doc count
+	return err
+}
+
+// readBlock decompress block i
+func (fr *FieldsReader) readBlock(i int) error {
+	if fr.block == i {
+		return nil
+	}
+	err := fr.fieldsData.seekFrom(fr.pointers[i])
+	if err != nil {
+		return err
+	}
+	numDocs, err := fr.fieldsData.readVarInt()
+	if err != nil {
+		return err
+	}
+	length, err := fr.fieldsData.readVarInt()
+	if err != nil {
+		return err
+	}
+	compressed, err := fr.fieldsData.readBytes()
+	if err != nil {
+		return err
+	}
+	data, err := fr.codec.Decompress(compressed, length)
+	if err != nil {
+		return err
+	}
 
+	r := bytes.NewReader(data)
+	lengths := make([]int, numDocs)
+	for k := range lengths {
+		l, err := binary.ReadUvarint(r)
+		if err != nil {
+			return err
+		}
+		lengths[k] = int(l)
+	}
+	start := len(data) - r.Len()
+	fr.docStarts = make([]int, numDocs+1)
+	for k, l := range lengths {
+		fr.docStarts[k] = start
+		start = start + l
+	}
+	fr.docStarts[numDocs] = start
+	if start != len(data) {
+		return fmt.Errorf("stored block %d of %d bytes, documents of %d", i, len(data), start)
+	}
+	fr.data = data
+	fr.block = i
+	return nil
 }
 
 // get doc
@@ -71,33 +168,57 @@ func (fr *FieldsReader) doc(n int64) (Document, error) {
 		err error
 	)
 
-	fr.fieldsIndex.seekFrom(n * 8) // get index offset
-
-	position, err := fr.fieldsIndex.readInt64()
+	if n < 0 || n >= fr.size {
+		return doc, fmt.Errorf("document %d out of range [0, %d)", n, fr.size)
+	}
+	block := sort.Search(len(fr.firstDocs), func(k int) bool { return fr.firstDocs[k] > n }) - 1
+	err = fr.readBlock(block)
 	if err != nil {
 		return doc, err
 	}
+	k := n - fr.firstDocs[block]
+	r := bytes.NewReader(fr.data[fr.docStarts[k]:fr.docStarts[k+1]])
 
-	fr.fieldsData.seekFrom(position)
-
-	numFields, err := fr.fieldsData.readVarInt()
+	numFields, err := binary.ReadUvarint(r)
 	if err != nil {
 		return doc, err
 	}
 
-	for i < numFields {
+	for i < int(numFields) {
 
-		fieldNumber, _ := fr.fieldsData.readVarInt()
-		fi, _ := fr.fieldInfos.getFieldInfo(fieldNumber)
-		b, _ := fr.fieldsData.readByte() // istoken info
-		v, _ := fr.fieldsData.readString()
+		fieldNumber, err := binary.ReadUvarint(r)
+		if err != nil {
+			return doc, err
+		}
+		fi, err := fr.fieldInfos.getFieldInfo(int(fieldNumber))
+		if err != nil {
+			return doc, err
+		}
+		b, err := r.ReadByte() // bit 1 tokenized, bit 2 binary
+		if err != nil {
+			return doc, err
+		}
+		l, err := binary.ReadUvarint(r)
+		if err != nil {
+			return doc, err
+		}
+		if l > uint64(r.Len()) {
+			return doc, fmt.Errorf("stored value of %d bytes past the end of document %d", l, n)
+		}
+		value := make([]byte, l)
+		r.Read(value)
 
 		field := Field{
 			name:        fi.name,
-			value:       v,
 			isStored:    true,
 			isIndexed:   fi.isIndexed,
 			isTokenized: (b & 1) != 0,
+			termVector:  fi.termVector(),
+		}
+		if (b & 2) != 0 {
+			field.binary = value
+		} else {
+			field.value = string(value)
 		}
 		doc.Add(field)
 
@@ -107,6 +228,15 @@ func (fr *FieldsReader) doc(n int64) (Document, error) {
 	return doc, nil
 }
 
+// close close fdt and fdx
+func (fr *FieldsReader) close() error {
+	err := fr.fieldsData.close()
+	if e := fr.fieldsIndex.close(); err == nil {
+		err = e
+	}
+	return err
+}
+
 // ================================TermsReader=======================================
 
 func (tr *TermsReader) init(dirPath string, segment string, fn *FieldInfos) error {
@@ -136,8 +266,16 @@ func (tr *TermsReader) init(dirPath string, segment string, fn *FieldInfos) erro
 
 	tr.fieldInfos = fn
 
-	tr.readIndex()
-	return nil
+	return tr.readIndex()
+}
+
+// close close tis and tii
+func (tr *TermsReader) close() error {
+	err := tr.termsData.close()
+	if e := tr.termsIndex.close(); err == nil {
+		err = e
+	}
+	return err
 }
 
 // terms get terms
@@ -151,7 +289,10 @@ func (tr *TermsReader) readIndex() error {
 	segTerms := new(SegmentTerms)
 
 	// get all term, termInfo
-	segTerms.init(tr.termsIndex, tr.termsData, tr.fieldInfos, true)
+	err := segTerms.init(tr.termsIndex, tr.termsData, tr.fieldInfos, false)
+	if err != nil {
+		return err
+	}
 
 	tr.segTerms = segTerms
 
@@ -182,8 +323,14 @@ func (st *SegmentTerms) init(tIndexPtr, tDataPtr *File, fieldInfos *FieldInfos,
 	// read term
 	i := 0
 	for i < n {
-		st.readTerm(tDataPtr)
-		st.readTermInfo(tDataPtr)
+		err = st.readTerm(tDataPtr)
+		if err != nil {
+			return err
+		}
+		err = st.readTermInfo(tDataPtr)
+		if err != nil {
+			return err
+		}
 		st.readIndexPtr(tDataPtr)
 		i = i + 1
 	}
@@ -191,33 +338,68 @@ func (st *SegmentTerms) init(tIndexPtr, tDataPtr *File, fieldInfos *FieldInfos,
 	return nil
 }
 
-// readTerm read and add term
+// readTerm read and add term, its text shares a prefix with the text of the term before
 func (st *SegmentTerms) readTerm(fPtr *File) error {
-	start, _ := fPtr.readVarInt()
-	length, _ := fPtr.readVarInt()
-	// totalLength := start + length ? why need total length
+	start, err := fPtr.readVarInt()
+	if err != nil {
+		return err
+	}
+	length, err := fPtr.readVarInt()
+	if err != nil {
+		return err
+	}
 
 	b := make([]byte, length)
-	fPtr.readChars(b, false, int64(start))
+	err = fPtr.readChars(b, false, int64(start))
+	if err != nil {
+		return err
+	}
+
+	prefix := ""
+	if n := len(st.terms); n > 0 {
+		prefix = st.terms[n-1].text
+	}
+	if start > len(prefix) {
+		return fmt.Errorf("term prefix %d longer than the term before", start)
+	}
 
-	i, _ := fPtr.readVarInt()
-	name, _ := st.fieldInfos.getFieldName(i)
+	i, err := fPtr.readVarInt()
+	if err != nil {
+		return err
+	}
+	name, err := st.fieldInfos.getFieldName(i)
+	if err != nil {
+		return err
+	}
 
 	term := Term{
 		field: name,
-		text:  string(b),
+		text:  prefix[:start] + string(b),
 	}
 	st.terms = append(st.terms, &term)
 	return nil
 
 }
 
-// readTermInfo read and add termInfo
+// readTermInfo read and add termInfo, the pointers are deltas of the termInfo before
 func (st *SegmentTerms) readTermInfo(fPtr *File) error {
 
-	docFrq, _ := fPtr.readVarInt()
-	frqPtr, _ := fPtr.readVarInt64()
-	prxPtr, _ := fPtr.readVarInt64()
+	docFrq, err := fPtr.readVarInt()
+	if err != nil {
+		return err
+	}
+	frqPtr, err := fPtr.readVarInt64()
+	if err != nil {
+		return err
+	}
+	prxPtr, err := fPtr.readVarInt64()
+	if err != nil {
+		return err
+	}
+	if n := len(st.termInfos); n > 0 {
+		frqPtr = frqPtr + st.termInfos[n-1].frqPtr
+		prxPtr = prxPtr + st.termInfos[n-1].prxPtr
+	}
 
 	ti := TermInfo{
 		docFrq: int64(docFrq),
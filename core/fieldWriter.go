@@ -1,15 +1,35 @@
 package core
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
 	"path"
 )
 
+/*
+A FieldsWriter buffers the stored fields of documents into blocks,
+a block is compressed and written to .fdt once it holds StoredFieldsBlockSize bytes or StoredFieldsBlockDocs documents.
+
+	.fdt  the codec name, then per block the document count, the uncompressed and compressed lengths,
+	      and the compressed data: the length of each document, then the documents
+	.fdx  per block its first document number and int64 pointer into .fdt, then the int64 document count
+
+A document is its stored field count, then per field the field number, the bits byte
+(bit 1 tokenized, bit 2 binary) and the string or bytes value.
+*/
+
 // FieldsWriter fields writer
 type FieldsWriter struct {
 	fieldInfos  *FieldInfos
 	fieldsData  *File
 	fieldsIndex *File
+	codec       StoredFieldsCodec
+	blockSize   int64        // bytes of a block
+	blockDocs   int64        // documents of a block
+	block       bytes.Buffer // documents of the current block
+	docLengths  []int        // length of each document of the current block
+	numDocs     int64        // documents written before the current block
 }
 
 // TermsWriter term info writer
@@ -18,6 +38,7 @@ type TermsWriter struct {
 	lastTerm         Term
 	lastTi           TermInfo
 	isIndex          bool
+	indexInterval    int64 // terms between entries of the index
 	size             int64
 	other            *TermsWriter
 	output           *File
@@ -30,9 +51,15 @@ type NormsWriter struct {
 
 // ================================FieldsWriter=======================================
 
-// Init init fieldsWriter
-func (fw *FieldsWriter) init(dirPath string, segment string, fn *FieldInfos) error {
+// Init init fieldsWriter, with the stored fields settings of config
+func (fw *FieldsWriter) init(dirPath string, segment string, fn *FieldInfos, config *IndexWriterConfig) error {
 	fw.fieldInfos = fn
+	fw.codec = config.StoredFieldsCompression
+	if fw.codec == nil {
+		fw.codec = NoCompressionCodec{}
+	}
+	fw.blockSize = config.StoredFieldsBlockSize
+	fw.blockDocs = config.StoredFieldsBlockDocs
 
 	filePath := path.Join(dirPath, segment+FileSuffix["fieldData"])
 
@@ -48,7 +75,7 @@ func (fw *FieldsWriter) init(dirPath string, segment string, fn *FieldInfos) err
 		return err
 	}
 	fw.fieldsIndex = fieldsIndex
-	return nil
+	return fw.fieldsData.writeString(fw.codec.Name())
 }
 
 // AddDocument add doc
@@ -56,70 +83,146 @@ func (fw *FieldsWriter) addDocument(doc Document) error {
 	var (
 		storedCount int64
 		err         error
-		size        int64
 	)
-	size, err = fw.fieldsIndex.getSize()
-	if err != nil {
-		return err
-	}
-	err = fw.fieldsIndex.writeInt64(size)
-	if err != nil {
-		return err
-	}
+	start := fw.block.Len()
 	storedCount = 0
 	for _, field := range doc.Fields {
 		if field.isStored {
 			storedCount = storedCount + 1
 		}
 	}
-	err = fw.fieldsData.writeVarInt64(storedCount)
-	if err != nil {
-		return err
-	}
+	appendVarInt(&fw.block, storedCount)
 	for _, field := range doc.Fields {
 		if field.isStored {
 			fieldName := field.name
-			fiNumber, _ := fw.fieldInfos.getNumber(fieldName)
-			err = fw.fieldsData.writeVarInt64(fiNumber)
+			fiNumber, err := fw.fieldInfos.getNumber(fieldName)
 			if err != nil {
 				return err
 			}
+			appendVarInt(&fw.block, fiNumber)
 			var bits byte
 			bits = 0
 			if field.isTokenized {
 				bits = bits | 1
 			}
-			fw.fieldsData.writeByte(bits)
-			fw.fieldsData.writeString(field.value)
+			if field.binary != nil {
+				bits = bits | 2
+			}
+			fw.block.WriteByte(bits)
+			if field.binary != nil {
+				appendVarInt(&fw.block, int64(len(field.binary)))
+				fw.block.Write(field.binary)
+			} else {
+				appendVarInt(&fw.block, int64(len(field.value)))
+				fw.block.WriteString(field.value)
+			}
 		}
 	}
+	fw.docLengths = append(fw.docLengths, fw.block.Len()-start)
+
+	if int64(fw.block.Len()) >= fw.blockSize || int64(len(fw.docLengths)) >= fw.blockDocs {
+		err = fw.flushBlock()
+	}
+	return err
+}
+
+// flushBlock compress and write the current block
+func (fw *FieldsWriter) flushBlock() error {
+	if len(fw.docLengths) == 0 {
+		return nil
+	}
+	var data bytes.Buffer
+	for _, length := range fw.docLengths {
+		appendVarInt(&data, int64(length))
+	}
+	data.Write(fw.block.Bytes())
+	compressed, err := fw.codec.Compress(data.Bytes())
+	if err != nil {
+		return err
+	}
+
+	pointer, err := fw.fieldsData.getSize() // where the block starts in fdt
+	if err != nil {
+		return err
+	}
+	err = fw.fieldsIndex.writeInt64(fw.numDocs)
+	if err != nil {
+		return err
+	}
+	err = fw.fieldsIndex.writeInt64(pointer)
+	if err != nil {
+		return err
+	}
+
+	err = fw.fieldsData.writeVarInt(len(fw.docLengths))
+	if err != nil {
+		return err
+	}
+	err = fw.fieldsData.writeVarInt(data.Len())
+	if err != nil {
+		return err
+	}
+	err = fw.fieldsData.writeBytes(compressed)
+	if err != nil {
+		return err
+	}
+
+	fw.numDocs = fw.numDocs + int64(len(fw.docLengths))
+	fw.docLengths = fw.docLengths[:0]
+	fw.block.Reset()
 	return nil
 }
 
-// Close flush file to disk
+// Close write the last block and the document count, close the files
 func (fw *FieldsWriter) Close() error {
 	var err error
-	err = fw.fieldsIndex.flush()
+	err = fw.flushBlock()
+	if err != nil {
+		return err
+	}
+	err = fw.fieldsIndex.writeInt64(fw.numDocs)
 	if err != nil {
 		return err
 	}
-	err = fw.fieldsData.flush()
+	err = fw.fieldsIndex.close()
+	if err != nil {
+		return err
+	}
+	err = fw.fieldsData.close()
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// abort close the files of a failed segment, without writing the buffered block
+func (fw *FieldsWriter) abort() {
+	if fw.fieldsIndex != nil {
+		fw.fieldsIndex.close()
+	}
+	if fw.fieldsData != nil {
+		fw.fieldsData.close()
+	}
+}
+
+// appendVarInt append a var int to a buffer, as writeVarInt64 writes it to a file
+func appendVarInt(b *bytes.Buffer, n int64) {
+	var buf [binary.MaxVarintLen64]byte
+	l := binary.PutUvarint(buf[:], uint64(n))
+	b.Write(buf[:l])
+}
+
 // ================================TermsWriter=======================================
 
-// Init termsWriter init
-func (tw *TermsWriter) init(dirPath, segment string, fieldInfos *FieldInfos) error {
+// Init termsWriter init, a term of every indexInterval terms goes to the index
+func (tw *TermsWriter) init(dirPath, segment string, fieldInfos *FieldInfos, indexInterval int64) error {
 	var (
 		err      error
 		fPtr     *File
 		filePath string
 	)
 	tw.fieldInfos = fieldInfos
+	tw.indexInterval = indexInterval
 
 	filePath = path.Join(dirPath, segment+FileSuffix["termInfos"])
 	fPtr, err = CreateFile(filePath, false, false)
@@ -168,14 +271,23 @@ func (tw *TermsWriter) addTerm(term Term, ti TermInfo) error {
 		return fmt.Errorf("proxPointer out of order")
 	}
 
-	if tw.isIndex == false && tw.size%IndexInterval == 0 {
-		tw.other.addTerm(tw.lastTerm, tw.lastTi)
+	if tw.isIndex == false && tw.size%tw.indexInterval == 0 {
+		err := tw.other.addTerm(tw.lastTerm, tw.lastTi)
+		if err != nil {
+			return err
+		}
 	}
 
-	tw.writeTerm(term)
-	tw.output.writeVarInt(int(ti.docFrq))
-	tw.output.writeVarInt64(ti.frqPtr - tw.lastTi.frqPtr)
-	tw.output.writeVarInt64(ti.prxPtr - tw.lastTi.prxPtr)
+	err := tw.writeTerm(term)
+	if err != nil {
+		return err
+	}
+	for _, n := range []int64{ti.docFrq, ti.frqPtr - tw.lastTi.frqPtr, ti.prxPtr - tw.lastTi.prxPtr} {
+		err = tw.output.writeVarInt64(n)
+		if err != nil {
+			return err
+		}
+	}
 
 	if tw.isIndex {
 		size, err := tw.other.output.getSize()
@@ -183,7 +295,10 @@ func (tw *TermsWriter) addTerm(term Term, ti TermInfo) error {
 			return err
 		}
 		n := size - tw.lastIndexPointer
-		tw.output.writeVarInt64(n)
+		err = tw.output.writeVarInt64(n)
+		if err != nil {
+			return err
+		}
 		nSize, err := tw.other.output.getSize()
 		if err != nil {
 			return err
@@ -191,6 +306,7 @@ func (tw *TermsWriter) addTerm(term Term, ti TermInfo) error {
 		tw.lastIndexPointer = nSize
 	}
 
+	tw.lastTerm = term
 	tw.lastTi.docFrq = ti.docFrq
 	tw.lastTi.frqPtr = ti.frqPtr
 	tw.lastTi.prxPtr = ti.prxPtr
@@ -206,28 +322,43 @@ func (tw *TermsWriter) writeTerm(term Term) error {
 	start := StringDifference(tw.lastTerm.text, term.text)
 	l := int64(len(term.text)) - start
 
-	tw.output.writeVarInt(int(start))       // write shared prefix length
-	tw.output.writeVarInt(int(l))           // write delta length
-	tw.output.writeChars(term.text[start:]) // write delta chars
+	err := tw.output.writeVarInt(int(start)) // write shared prefix length
+	if err != nil {
+		return err
+	}
+	err = tw.output.writeVarInt(int(l)) // write delta length
+	if err != nil {
+		return err
+	}
+	err = tw.output.writeChars(term.text[start:]) // write delta chars
+	if err != nil {
+		return err
+	}
 
 	n, err := tw.fieldInfos.getNumber(term.field)
 	if err != nil {
 		return err
 	}
-	tw.output.writeVarInt(int(n))
-	return nil
+	return tw.output.writeVarInt(int(n))
 }
 
 // Close flush file to disk
 func (tw *TermsWriter) close() error {
-
-	tw.output.seekFrom(0) // write size at start
-	tw.output.writeInt(int(tw.size))
-
-	tw.output.flush()
+	err := tw.output.seekFrom(0) // write size at start
+	if err == nil {
+		err = tw.output.writeInt(int(tw.size))
+	}
+	if err == nil {
+		err = tw.output.flush()
+	}
 
 	if !tw.isIndex {
-		tw.other.close()
+		if e := tw.other.close(); err == nil {
+			err = e
+		}
 	}
-	return nil
+	if e := tw.output.close(); err == nil {
+		err = e
+	}
+	return err
 }
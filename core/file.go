@@ -2,6 +2,7 @@ package core
 
 import (
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 )
@@ -164,11 +165,8 @@ func (f *File) readInt64() (int64, error) {
 
 // WriteInt write int
 func (f *File) writeInt(n int) error {
-	f.writeByte(byte(n >> 24))
-	f.writeByte(byte(n >> 16))
-	f.writeByte(byte(n >> 8))
-	f.writeByte(byte(n))
-	return nil
+	_, err := f.file.Write([]byte{byte(n >> 24), byte(n >> 16), byte(n >> 8), byte(n)})
+	return err
 }
 
 // readint read int
@@ -182,52 +180,46 @@ func (f *File) readInt() (int, error) {
 	if err != nil {
 		return i, err
 	}
-	b1 := b & 0xFF << 24
+	b1 := int(b) << 24
 
 	b, err = f.readByte()
 	if err != nil {
 		return i, err
 	}
-	b2 := b & 0xFF << 16
+	b2 := int(b) << 16
 
 	b, err = f.readByte()
 	if err != nil {
 		return i, err
 	}
-	b3 := b & 0xFF << 8
+	b3 := int(b) << 8
 
 	b, err = f.readByte()
 	if err != nil {
 		return i, err
 	}
-	b4 := b & 0xFF
+	b4 := int(b)
 
-	i = int(b1 | b2 | b3 | b4)
+	i = b1 | b2 | b3 | b4
 	return i, nil
 }
 
 // WriteVarInt64 write var int64
 func (f *File) writeVarInt64(n int64) error {
-	for (n & ^0x7F) != 0 {
-		s1 := byte((n & 0x7f) | 0x80)
-		f.writeByte(s1)
-		n >>= 7
-	}
-	s2 := byte(n)
-	f.writeByte(s2)
-	return nil
+	u := uint64(n) // a negative number takes 10 bytes rather than looping forever
+	b := make([]byte, 0, 10)
+	for u >= 0x80 {
+		b = append(b, byte(u&0x7f|0x80))
+		u >>= 7
+	}
+	b = append(b, byte(u))
+	_, err := f.file.Write(b)
+	return err
 }
 
 // WriteVarInt write var int
 func (f *File) writeVarInt(n int) error {
-	for (n & ^0x7F) != 0 {
-		s1 := byte((n & 0x7f) | 0x80)
-		f.writeByte(s1)
-		n >>= 7
-	}
-	s2 := byte(n)
-	f.writeByte(s2)
-	return nil
+	return f.writeVarInt64(int64(n))
 }
 
 // ReadVarInt64 read
@@ -264,14 +256,20 @@ func (f *File) readVarInt() (int, error) {
 		shift int
 	)
 
-	b, _ = f.readByte()
+	b, err := f.readByte()
+	if err != nil {
+		return 0, err
+	}
 
 	shift = 7
 
 	i = int(b & 0x7F)
 	for (b & 0x80) != 0 {
-		b, _ = f.readByte()
-		i = i | int((b&0x7F)<<shift)
+		b, err = f.readByte()
+		if err != nil {
+			return i, err
+		}
+		i = i | int(b&0x7F)<<shift
 		shift += 7
 	}
 	return i, nil
@@ -330,13 +328,37 @@ func (f *File) readString() (string, error) {
 	}
 
 	b := make([]byte, n)
-	_, err = f.file.Read(b)
+	_, err = io.ReadFull(f.file, b)
 	if err != nil {
 		return "", err
 	}
 	return string(b), nil
 }
 
+// writeBytes write length and bytes
+func (f *File) writeBytes(b []byte) error {
+	err := f.writeVarInt(len(b)) // (1) length
+	if err != nil {
+		return err
+	}
+	_, err = f.file.Write(b) // (2) bytes
+	return err
+}
+
+// readBytes read length and bytes
+func (f *File) readBytes() ([]byte, error) {
+	n, err := f.readVarInt()
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, n)
+	_, err = io.ReadFull(f.file, b)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
 // WriteByte write string
 func (f *File) writeByte(b byte) error {
 	var (
@@ -0,0 +1,187 @@
+package core
+
+import (
+	"sort"
+	"strings"
+)
+
+/*
+A TokenFilter is a TokenStream whose input is another token stream.
+Filters embed TokenFilter, so closing a filter closes the whole chain.
+*/
+
+// TokenFilter token filter
+type TokenFilter struct {
+	input TokenStream
+}
+
+// LowerCaseFilter normalize token text to lower case
+type LowerCaseFilter struct {
+	TokenFilter
+}
+
+/*
+A FlattenGraphFilter converts a token graph,
+such as the output of a SynonymFilter,
+into a flat stream that can be indexed.
+
+The index only records a position per token, not its position length,
+so a side path of a graph would otherwise land on positions owned by another path.
+Each node of the graph is moved to the length of the longest path that reaches it,
+so every path through the graph occupies consecutive positions.
+*/
+
+// FlattenGraphFilter flatten token graph
+type FlattenGraphFilter struct {
+	TokenFilter
+	pending   []*Token // tokens of the current graph section
+	nodes     []int64  // start node of each pending token
+	maxEnd    int64    // furthest end node of the pending tokens
+	node      int64    // start node of the last token read
+	emitted   []*Token // flattened tokens ready to be returned
+	lastFlat  int64    // flattened position of the last emitted token
+	flatBase  int64    // flattened position of the current section start
+	baseNode  int64    // graph node of the current section start
+	exhausted bool
+}
+
+// NewLowerCaseFilter new lower case filter
+func NewLowerCaseFilter(input TokenStream) *LowerCaseFilter {
+	return &LowerCaseFilter{TokenFilter{input: input}}
+}
+
+// NewFlattenGraphFilter new flatten graph filter
+func NewFlattenGraphFilter(input TokenStream) *FlattenGraphFilter {
+	return &FlattenGraphFilter{
+		TokenFilter: TokenFilter{input: input},
+		node:        -1,
+		lastFlat:    -1,
+		baseNode:    -1,
+	}
+}
+
+// positionLength position length, at least one
+func positionLength(t *Token) int64 {
+	if t.PositionLength < 1 {
+		return 1
+	}
+	return t.PositionLength
+}
+
+// ================================TokenFilter=======================================
+
+// Close close input
+func (tf *TokenFilter) Close() error {
+	return tf.input.Close()
+}
+
+// ================================LowerCaseFilter=======================================
+
+// Next next token
+func (lf *LowerCaseFilter) Next() (*Token, error) {
+	t, err := lf.input.Next()
+	if t == nil || err != nil {
+		return t, err
+	}
+	t.TermText = strings.ToLower(t.TermText)
+	return t, nil
+}
+
+// ================================FlattenGraphFilter=======================================
+
+// Next next token
+func (ff *FlattenGraphFilter) Next() (*Token, error) {
+	for len(ff.emitted) == 0 {
+		if ff.exhausted {
+			return nil, nil
+		}
+		t, err := ff.input.Next()
+		if err != nil {
+			return nil, err
+		}
+		if t == nil {
+			ff.exhausted = true
+			ff.flush()
+			continue
+		}
+
+		ff.node = ff.node + t.PositionIncrement
+		if len(ff.pending) > 0 && ff.node >= ff.maxEnd { // all paths joined
+			ff.flush()
+		}
+		if len(ff.pending) == 0 {
+			ff.startSection()
+		}
+		ff.pending = append(ff.pending, t)
+		ff.nodes = append(ff.nodes, ff.node)
+		if end := ff.node + positionLength(t); end > ff.maxEnd {
+			ff.maxEnd = end
+		}
+	}
+
+	t := ff.emitted[0]
+	ff.emitted = ff.emitted[1:]
+	return t, nil
+}
+
+// startSection a new section starts at the current node
+func (ff *FlattenGraphFilter) startSection() {
+	if ff.baseNode < 0 { // first section
+		ff.flatBase = ff.node
+	} else {
+		ff.flatBase = ff.flatBase + (ff.node - ff.baseNode)
+	}
+	ff.baseNode = ff.node
+	ff.maxEnd = ff.node
+}
+
+// flush move pending tokens to their longest path positions
+func (ff *FlattenGraphFilter) flush() {
+	if len(ff.pending) == 0 {
+		return
+	}
+
+	depth := map[int64]int64{ff.baseNode: 0} // longest path to each node
+	flats := make([]int64, len(ff.pending))
+	prevFrom := ff.baseNode
+	for i, t := range ff.pending {
+		from := ff.nodes[i]
+		if _, found := depth[from]; !found { // a gap inside the section
+			depth[from] = depth[prevFrom] + (from - prevFrom)
+		}
+		to := from + positionLength(t)
+		if d := depth[from] + 1; d > depth[to] {
+			depth[to] = d
+		}
+		flats[i] = ff.flatBase + depth[from]
+		prevFrom = from
+	}
+
+	order := make([]int, len(ff.pending))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return flats[order[i]] < flats[order[j]]
+	})
+
+	for _, i := range order {
+		t := ff.pending[i]
+		from := ff.nodes[i]
+		to := from + positionLength(t)
+		if ff.lastFlat < 0 {
+			t.PositionIncrement = flats[i] + 1
+		} else {
+			t.PositionIncrement = flats[i] - ff.lastFlat
+		}
+		t.PositionLength = depth[to] - depth[from]
+		ff.lastFlat = flats[i]
+		ff.emitted = append(ff.emitted, t)
+	}
+
+	// the next section continues after the longest path
+	ff.flatBase = ff.flatBase + depth[ff.maxEnd]
+	ff.baseNode = ff.maxEnd
+	ff.pending = ff.pending[:0]
+	ff.nodes = ff.nodes[:0]
+}
@@ -0,0 +1,241 @@
+package core
+
+import "fmt"
+
+/*
+An IndexReader reads the segments of an index written by a Writer.
+
+Documents are numbered across the segments, in the order of the segments file,
+document n of the index is document n - start of the segment it falls in.
+Deleted documents keep their numbers until their segment is merged, MaxDoc counts them, NumDocs does not,
+TermDocs and the queries skip them, DocFreq still counts them.
+Close should be called when the reader is no longer needed.
+
+The doc values of the index join the columns of the segments, with ordinals over all segments,
+they are built on each call, so keep them rather than asking per document.
+*/
+
+// IndexReader index reader
+type IndexReader struct {
+	dirPath  string
+	segInfos *SegmentInfos
+	readers  []*SegmentReader
+	starts   []int64 // first document number of each segment
+	maxDoc   int64
+	schema   *Schema
+}
+
+// OpenIndexReader open the index of a directory
+func OpenIndexReader(dirPath string) (*IndexReader, error) {
+	segInfos := new(SegmentInfos)
+	err := segInfos.read(dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := readIndexSchema(dirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	ir := &IndexReader{
+		dirPath:  dirPath,
+		segInfos: segInfos,
+		schema:   schema,
+	}
+	for _, si := range segInfos.segInfos {
+		reader := new(SegmentReader)
+		err = reader.init(si)
+		if err != nil {
+			ir.Close()
+			return nil, err
+		}
+		ir.readers = append(ir.readers, reader)
+		ir.starts = append(ir.starts, ir.maxDoc)
+		ir.maxDoc = ir.maxDoc + reader.maxDoc()
+	}
+	return ir, nil
+}
+
+// Schema declared fields of the index, nil when undeclared
+func (ir *IndexReader) Schema() *Schema {
+	return ir.schema
+}
+
+// MaxDoc one greater than the largest document number
+func (ir *IndexReader) MaxDoc() int64 {
+	return ir.maxDoc
+}
+
+// NumDocs number of documents not deleted
+func (ir *IndexReader) NumDocs() int64 {
+	n := int64(0)
+	for _, reader := range ir.readers {
+		n = n + reader.numDocs()
+	}
+	return n
+}
+
+// Document stored fields of the nth document
+func (ir *IndexReader) Document(n int64) (Document, error) {
+	i, err := ir.readerIndex(n)
+	if err != nil {
+		return Document{}, err
+	}
+	if ir.readers[i].isDeleted(n - ir.starts[i]) {
+		return Document{}, fmt.Errorf("document %d is deleted", n)
+	}
+	return ir.readers[i].fieldsReader.doc(n - ir.starts[i])
+}
+
+// IsDeleted the nth document is deleted
+func (ir *IndexReader) IsDeleted(n int64) bool {
+	i, err := ir.readerIndex(n)
+	if err != nil {
+		return false
+	}
+	return ir.readers[i].isDeleted(n - ir.starts[i])
+}
+
+// HasDeletions some document of the index is deleted
+func (ir *IndexReader) HasDeletions() bool {
+	return ir.NumDocs() < ir.maxDoc
+}
+
+// withDeleted view of the index where no document is deleted, sharing the segment files
+func (ir *IndexReader) withDeleted() *IndexReader {
+	if !ir.HasDeletions() {
+		return ir
+	}
+	view := *ir
+	view.readers = make([]*SegmentReader, len(ir.readers))
+	for i, sr := range ir.readers {
+		r := *sr
+		r.deletedDocs = nil
+		view.readers[i] = &r
+	}
+	return &view
+}
+
+// TermVector term vector of a field of the nth document, nil when the field has none
+func (ir *IndexReader) TermVector(n int64, fieldName string) (*TermFreqVector, error) {
+	i, err := ir.readerIndex(n)
+	if err != nil {
+		return nil, err
+	}
+	return ir.readers[i].termVector(n-ir.starts[i], fieldName)
+}
+
+// DocFreq number of documents containing a term, deleted or not
+func (ir *IndexReader) DocFreq(fieldName, text string) int64 {
+	term := Term{field: fieldName, text: text}
+	n := int64(0)
+	for _, reader := range ir.readers {
+		n = n + reader.docFreq(term)
+	}
+	return n
+}
+
+// TermDocs documents containing a term, by index document number in document order
+func (ir *IndexReader) TermDocs(fieldName, text string) ([]TermDoc, error) {
+	termDocs := []TermDoc{}
+	for i, reader := range ir.readers {
+		segDocs, err := reader.TermDocs(fieldName, text)
+		if err != nil {
+			return nil, err
+		}
+		for _, td := range segDocs {
+			td.Doc = td.Doc + ir.starts[i]
+			termDocs = append(termDocs, td)
+		}
+	}
+	return termDocs, nil
+}
+
+// Segments readers of the segments, document n of segment i is document DocBase(i)+n of the index
+func (ir *IndexReader) Segments() []*SegmentReader {
+	return ir.readers
+}
+
+// DocBase number of the first document of segment i
+func (ir *IndexReader) DocBase(i int) int64 {
+	return ir.starts[i]
+}
+
+// docValuesColumn column of a field over all segments, the ordinals of sorted values are global
+func (ir *IndexReader) docValuesColumn(fieldName string, typ DocValuesType) (*docValuesColumn, error) {
+	columns := []*docValuesColumn{}
+	sizes := []int64{}
+	for _, reader := range ir.readers {
+		column, err := reader.docValuesColumn(fieldName, typ)
+		if err != nil {
+			return nil, err
+		}
+		columns = append(columns, column)
+		sizes = append(sizes, reader.maxDoc())
+	}
+	if len(columns) == 1 && columns[0] != nil {
+		return columns[0], nil
+	}
+	return mergeDocValues(typ, columns, sizes), nil
+}
+
+// NumericDocValues numeric doc values of a field by index document number
+func (ir *IndexReader) NumericDocValues(fieldName string) (*NumericDocValues, error) {
+	column, err := ir.docValuesColumn(fieldName, DocValuesNumeric)
+	if err != nil {
+		return nil, err
+	}
+	return &NumericDocValues{column: column}, nil
+}
+
+// SortedDocValues sorted doc values of a field by index document number
+func (ir *IndexReader) SortedDocValues(fieldName string) (*SortedDocValues, error) {
+	column, err := ir.docValuesColumn(fieldName, DocValuesSorted)
+	if err != nil {
+		return nil, err
+	}
+	return &SortedDocValues{column: column}, nil
+}
+
+// SortedSetDocValues sorted set doc values of a field by index document number
+func (ir *IndexReader) SortedSetDocValues(fieldName string) (*SortedSetDocValues, error) {
+	column, err := ir.docValuesColumn(fieldName, DocValuesSortedSet)
+	if err != nil {
+		return nil, err
+	}
+	return &SortedSetDocValues{column: column}, nil
+}
+
+// BinaryDocValues binary doc values of a field by index document number
+func (ir *IndexReader) BinaryDocValues(fieldName string) (*BinaryDocValues, error) {
+	column, err := ir.docValuesColumn(fieldName, DocValuesBinary)
+	if err != nil {
+		return nil, err
+	}
+	return &BinaryDocValues{column: column}, nil
+}
+
+// readerIndex segment holding the nth document
+func (ir *IndexReader) readerIndex(n int64) (int, error) {
+	if n < 0 || n >= ir.maxDoc {
+		return 0, fmt.Errorf("document %d out of range [0, %d)", n, ir.maxDoc)
+	}
+	i := len(ir.starts) - 1
+	for ir.starts[i] > n {
+		i = i - 1
+	}
+	return i, nil
+}
+
+// Close close the segment files
+func (ir *IndexReader) Close() error {
+	var err error
+	for _, reader := range ir.readers {
+		if e := reader.close(); err == nil {
+			err = e
+		}
+	}
+	ir.readers = nil
+	return err
+}
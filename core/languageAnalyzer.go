@@ -0,0 +1,158 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"unicode/utf8"
+)
+
+/*
+A LanguageAnalyzer detects the language of each field value
+and analyzes it with the analyzer configured for that language,
+so a field mixing chinese, japanese and english values gets the right analysis for each value.
+Values in a language without an analyzer, or without letters, use the default analyzer.
+
+Detection looks at the first DetectBytes bytes of a value, 4096 by default or when not positive,
+the rest of the value is streamed to the chosen analyzer without being buffered.
+
+At query time the same analyzer detects the language of the query text,
+ForLanguage picks the analyzer of a language declared by the user instead.
+TagLanguage records the detected language of a field as a keyword field of the document,
+so searches can be restricted to one language.
+*/
+
+// LanguageAnalyzer analyzer chosen by detected language
+type LanguageAnalyzer struct {
+	DetectBytes int // bytes of a field value read to detect its language, 4096 when not positive
+
+	identifier      *LanguageIdentifier
+	defaultAnalyzer Analyzer
+	analyzers       map[string]Analyzer
+}
+
+// NewLanguageAnalyzer new language analyzer, identifier nil for the default identifier
+func NewLanguageAnalyzer(identifier *LanguageIdentifier, defaultAnalyzer Analyzer, analyzers map[string]Analyzer) *LanguageAnalyzer {
+	if identifier == nil {
+		identifier = DefaultLanguageIdentifier()
+	}
+	la := &LanguageAnalyzer{
+		DetectBytes:     4096,
+		identifier:      identifier,
+		defaultAnalyzer: defaultAnalyzer,
+		analyzers:       map[string]Analyzer{},
+	}
+	for language, analyzer := range analyzers {
+		la.analyzers[language] = analyzer
+	}
+	return la
+}
+
+// ================================LanguageAnalyzer=======================================
+
+// AddAnalyzer use analyzer for the language
+func (la *LanguageAnalyzer) AddAnalyzer(language string, analyzer Analyzer) {
+	la.analyzers[language] = analyzer
+}
+
+// ForLanguage analyzer of a declared language
+func (la *LanguageAnalyzer) ForLanguage(language string) Analyzer {
+	if analyzer, found := la.analyzers[language]; found {
+		return analyzer
+	}
+	return la.defaultAnalyzer
+}
+
+// Detect language of text
+func (la *LanguageAnalyzer) Detect(text string) string {
+	language, _ := la.identifier.Detect(text)
+	return language
+}
+
+// TokenStream token stream of the analyzer of the detected language
+func (la *LanguageAnalyzer) TokenStream(fieldName string, reader io.Reader) (TokenStream, error) {
+	language, reader, err := la.detectPrefix(reader)
+	if err != nil {
+		return nil, err
+	}
+	analyzer := la.ForLanguage(language)
+	if analyzer == nil {
+		return nil, fmt.Errorf("no analyzer for language %q of field %s", language, fieldName)
+	}
+	return analyzer.TokenStream(fieldName, reader)
+}
+
+// PositionIncrementGap gap of the default analyzer, the language of the next value is not known yet
+func (la *LanguageAnalyzer) PositionIncrementGap(fieldName string) int64 {
+	if la.defaultAnalyzer == nil {
+		return 0
+	}
+	return positionIncrementGap(la.defaultAnalyzer, fieldName)
+}
+
+// detectPrefix detect the language of the start of reader, return a reader of the whole value
+func (la *LanguageAnalyzer) detectPrefix(reader io.Reader) (string, io.Reader, error) {
+	detectBytes := la.DetectBytes
+	if detectBytes <= 0 {
+		detectBytes = 4096
+	}
+	prefix := make([]byte, detectBytes)
+	n, err := io.ReadFull(reader, prefix)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", nil, err
+	}
+	prefix = prefix[:n]
+
+	text := prefix
+	if n == detectBytes { // the last rune may be cut in half
+		text = completeRunes(prefix)
+	}
+	language, _ := la.identifier.Detect(string(text))
+
+	whole := io.MultiReader(bytes.NewReader(prefix), reader)
+	if closer, ok := reader.(io.Closer); ok {
+		return language, &readCloser{Reader: whole, Closer: closer}, nil
+	}
+	return language, whole, nil
+}
+
+// TagLanguage add the detected language of each value of fieldName as keyword field languageField
+func (la *LanguageAnalyzer) TagLanguage(doc *Document, fieldName string, languageField string) error {
+	fields := doc.Fields
+	for _, field := range fields {
+		if field.name != fieldName {
+			continue
+		}
+		language := la.Detect(field.value)
+		if language == "" {
+			continue
+		}
+		f, err := Keyword(languageField, language)
+		if err != nil {
+			return err
+		}
+		err = doc.Add(f)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readCloser reader closing the source it was built from
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// completeRunes p without its last rune when that rune is cut
+func completeRunes(p []byte) []byte {
+	i := len(p) - 1
+	for i > 0 && !utf8.RuneStart(p[i]) {
+		i = i - 1
+	}
+	if i < 0 || utf8.FullRune(p[i:]) {
+		return p
+	}
+	return p[:i]
+}
@@ -0,0 +1,241 @@
+package core
+
+import (
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+/*
+A LanguageIdentifier tells the language of a text from its character n-grams.
+
+Each language has a profile, the counts of the 1 to 3 rune grams of some training text,
+and of the script of every letter.
+A text is scored against every profile by the likelihood of its grams (naive bayes, add-one smoothing),
+so a single kana is strong evidence of japanese and han without kana points to chinese.
+Kanbun annotated with kana is detected as japanese, bare kanbun can not be told from chinese.
+Only the first MaxDetectRunes letters of a text are looked at, 1000 by default.
+
+DefaultLanguageIdentifier knows en, fr, de, es, zh, ja and ko,
+more languages are added with AddProfile, and MaxDetectRunes set, before the identifier is shared between goroutines.
+DefaultLanguageIdentifier is shared already, a copy of it may be changed.
+*/
+
+// LanguageIdentifier n-gram language identifier
+type LanguageIdentifier struct {
+	MaxDetectRunes int // letters of a text used for detection, 0 for all
+
+	profiles   map[string]*languageProfile
+	vocabulary []int // distinct grams of each order over all profiles
+}
+
+// LanguageScore probability of a language
+type LanguageScore struct {
+	Language    string
+	Probability float64
+}
+
+// languageProfile gram counts of a language
+type languageProfile struct {
+	counts map[string]int64
+	totals []int64 // grams of each order
+}
+
+// maxLanguageGram longest gram of a profile, order 0 is the script
+const maxLanguageGram = 3
+
+var (
+	defaultIdentifierOnce sync.Once
+	defaultIdentifier     *LanguageIdentifier
+)
+
+// NewLanguageIdentifier new identifier without profiles
+func NewLanguageIdentifier() *LanguageIdentifier {
+	return &LanguageIdentifier{
+		MaxDetectRunes: 1000,
+		profiles:       map[string]*languageProfile{},
+	}
+}
+
+// DefaultLanguageIdentifier identifier of the builtin languages, shared
+func DefaultLanguageIdentifier() *LanguageIdentifier {
+	defaultIdentifierOnce.Do(func() {
+		defaultIdentifier = NewLanguageIdentifier()
+		for language, text := range languageSamples {
+			defaultIdentifier.AddProfile(language, text)
+		}
+	})
+	return defaultIdentifier
+}
+
+// languageGrams script and 1 to 3 rune grams of the words of text, of its first maxLetters letters, 0 for all
+func languageGrams(text string, maxLetters int, visit func(order int, gram string)) {
+	letters := 0
+	word := []rune{' '}
+	flush := func() {
+		if len(word) == 1 {
+			return
+		}
+		word = append(word, ' ')
+		for n := 1; n <= maxLanguageGram; n++ {
+			for i := 0; i+n <= len(word); i++ {
+				if n == 1 && word[i] == ' ' {
+					continue
+				}
+				visit(n, string(word[i:i+n]))
+			}
+		}
+		word = word[:1]
+	}
+
+	for _, r := range text {
+		if !unicode.IsLetter(r) && !unicode.Is(unicode.Mn, r) {
+			flush()
+			continue
+		}
+		if maxLetters > 0 && letters == maxLetters {
+			break
+		}
+		letters = letters + 1
+		visit(0, scriptOf(r))
+		word = append(word, unicode.ToLower(r))
+	}
+	flush()
+}
+
+// scriptOf script feature of a letter
+func scriptOf(r rune) string {
+	switch {
+	case unicode.Is(unicode.Han, r):
+		return "#han"
+	case unicode.In(r, unicode.Hiragana, unicode.Katakana):
+		return "#kana"
+	case unicode.Is(unicode.Hangul, r):
+		return "#hangul"
+	case unicode.Is(unicode.Latin, r):
+		return "#latin"
+	case unicode.Is(unicode.Cyrillic, r):
+		return "#cyrillic"
+	}
+	return "#other"
+}
+
+// ================================LanguageIdentifier=======================================
+
+// AddProfile add training text of a language
+func (li *LanguageIdentifier) AddProfile(language string, text string) {
+	profile, found := li.profiles[language]
+	if !found {
+		profile = &languageProfile{
+			counts: map[string]int64{},
+			totals: make([]int64, maxLanguageGram+1),
+		}
+		li.profiles[language] = profile
+	}
+	languageGrams(text, 0, func(order int, gram string) {
+		profile.counts[gram] = profile.counts[gram] + 1
+		profile.totals[order] = profile.totals[order] + 1
+	})
+	li.countVocabulary()
+}
+
+// Languages languages with a profile, sorted
+func (li *LanguageIdentifier) Languages() []string {
+	languages := []string{}
+	for language := range li.profiles {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+// Detect most probable language of text and its probability, "" for a text without letters
+func (li *LanguageIdentifier) Detect(text string) (string, float64) {
+	scores := li.Scores(text)
+	if len(scores) == 0 {
+		return "", 0
+	}
+	return scores[0].Language, scores[0].Probability
+}
+
+// DetectReader most probable language of the text of reader
+func (li *LanguageIdentifier) DetectReader(reader io.Reader) (string, float64, error) {
+	var b strings.Builder
+	_, err := io.Copy(&b, reader)
+	if err != nil {
+		return "", 0, err
+	}
+	language, probability := li.Detect(b.String())
+	return language, probability, nil
+}
+
+// Scores probability of every language, most probable first
+func (li *LanguageIdentifier) Scores(text string) []LanguageScore {
+	if len(li.profiles) == 0 {
+		return nil
+	}
+
+	grams := map[string]int{}
+	orders := map[string]int{}
+	languageGrams(text, li.MaxDetectRunes, func(order int, gram string) {
+		grams[gram] = grams[gram] + 1
+		orders[gram] = order
+	})
+	if len(grams) == 0 {
+		return nil
+	}
+
+	scores := []LanguageScore{}
+	best := math.Inf(-1)
+	for _, language := range li.Languages() {
+		profile := li.profiles[language]
+		logLikelihood := 0.0
+		for gram, n := range grams {
+			order := orders[gram]
+			p := float64(profile.counts[gram]+1) / float64(profile.totals[order]+int64(li.vocabulary[order]))
+			logLikelihood = logLikelihood + float64(n)*math.Log(p)
+		}
+		scores = append(scores, LanguageScore{Language: language, Probability: logLikelihood})
+		if logLikelihood > best {
+			best = logLikelihood
+		}
+	}
+
+	// normalize the likelihoods to probabilities
+	sum := 0.0
+	for i := range scores {
+		scores[i].Probability = math.Exp(scores[i].Probability - best)
+		sum = sum + scores[i].Probability
+	}
+	for i := range scores {
+		scores[i].Probability = scores[i].Probability / sum
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].Probability > scores[j].Probability
+	})
+	return scores
+}
+
+// countVocabulary count the distinct grams of each order
+func (li *LanguageIdentifier) countVocabulary() {
+	seen := map[string]int{}
+	for _, profile := range li.profiles {
+		for gram := range profile.counts {
+			order := len([]rune(gram))
+			if strings.HasPrefix(gram, "#") {
+				order = 0
+			}
+			seen[gram] = order
+		}
+	}
+	li.vocabulary = make([]int, maxLanguageGram+1)
+	for _, order := range seen {
+		li.vocabulary[order] = li.vocabulary[order] + 1
+	}
+	for order := range li.vocabulary {
+		li.vocabulary[order] = li.vocabulary[order] + 1 // room for unseen grams
+	}
+}
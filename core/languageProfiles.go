@@ -0,0 +1,75 @@
+package core
+
+// languageSamples training text of the default language identifier,
+// a few paragraphs per language are enough to tell these languages apart
+var languageSamples = map[string]string{
+	"en": `Before my bed the moonlight is so bright that I wonder if it is frost upon the ground.
+I raise my head and look at the bright moon, then lower it and think of my home far away.
+The poet wrote this short poem while he was travelling, and it is still the first poem that many children learn.
+Translations of classical poetry are never exact, because every translator has to choose between the sound,
+the image and the meaning of the original lines. Some of them keep the rhyme and lose the words,
+others keep the words and lose the music. This collection brings together several English versions of each poem,
+with notes about the author, the period and the places named in the text.
+Readers who search for a poem often remember only a phrase, the name of a river or mountain,
+or the name of a friend who was saying goodbye at the ferry. The index should find the poem anyway.
+We would like to thank the librarians and the volunteers who checked the spelling of the names.
+The mountains are high and the rivers are long, and the traveller walks alone through the autumn wind.
+When will the wine be finished, and when will the guests go home? Nobody knows the answer tonight.`,
+
+	"fr": `Devant mon lit la lumière de la lune est si claire que je crois voir du givre sur le sol.
+Je lève la tête pour regarder la lune brillante, puis je la baisse et je pense à mon pays natal.
+Le poète a écrit ce court poème pendant un voyage, et c'est encore le premier poème que les enfants apprennent.
+Les traductions de la poésie classique ne sont jamais exactes, car chaque traducteur doit choisir entre le son,
+l'image et le sens des vers originaux. Certains gardent la rime et perdent les mots,
+d'autres gardent les mots et perdent la musique. Cette collection réunit plusieurs versions françaises de chaque poème,
+avec des notes sur l'auteur, l'époque et les lieux nommés dans le texte.
+Les lecteurs qui cherchent un poème ne se souviennent souvent que d'une phrase, du nom d'une rivière ou d'une montagne.
+Nous remercions les bibliothécaires et les bénévoles qui ont vérifié l'orthographe des noms.`,
+
+	"de": `Vor meinem Bett ist das Mondlicht so hell, dass ich glaube, es sei Reif auf dem Boden.
+Ich hebe den Kopf und schaue zum hellen Mond, dann senke ich ihn und denke an meine ferne Heimat.
+Der Dichter schrieb dieses kurze Gedicht auf einer Reise, und es ist noch immer das erste Gedicht, das viele Kinder lernen.
+Übersetzungen klassischer Dichtung sind niemals genau, weil jeder Übersetzer zwischen dem Klang,
+dem Bild und der Bedeutung der ursprünglichen Zeilen wählen muss. Manche bewahren den Reim und verlieren die Wörter,
+andere bewahren die Wörter und verlieren die Musik. Diese Sammlung vereint mehrere deutsche Fassungen jedes Gedichts,
+mit Anmerkungen über den Verfasser, die Zeit und die Orte, die im Text genannt werden.
+Wir danken den Bibliothekaren und den Freiwilligen, welche die Schreibung der Namen geprüft haben.`,
+
+	"es": `Delante de mi cama la luz de la luna es tan clara que creo ver escarcha sobre el suelo.
+Levanto la cabeza para mirar la luna brillante, luego la bajo y pienso en mi tierra lejana.
+El poeta escribió este breve poema durante un viaje, y todavía es el primer poema que aprenden muchos niños.
+Las traducciones de la poesía clásica nunca son exactas, porque cada traductor tiene que elegir entre el sonido,
+la imagen y el sentido de los versos originales. Algunos conservan la rima y pierden las palabras,
+otros conservan las palabras y pierden la música. Esta colección reúne varias versiones españolas de cada poema,
+con notas sobre el autor, la época y los lugares que se nombran en el texto.
+Agradecemos a los bibliotecarios y a los voluntarios que revisaron la ortografía de los nombres.`,
+
+	"zh": `床前明月光，疑是地上霜。举头望明月，低头思故乡。
+白日依山尽，黄河入海流。欲穷千里目，更上一层楼。
+春眠不觉晓，处处闻啼鸟。夜来风雨声，花落知多少。
+国破山河在，城春草木深。感时花溅泪，恨别鸟惊心。
+故人西辞黄鹤楼，烟花三月下扬州。孤帆远影碧空尽，唯见长江天际流。
+牀前明月光，疑是地上霜。舉頭望明月，低頭思故鄉。
+國破山河在，城春草木深。感時花濺淚，恨別鳥驚心。
+这首诗是诗人在旅途中写的，直到今天仍然是许多孩子学会的第一首诗。
+古典诗词的翻译从来都不是完全准确的，因为每一位译者都必须在声音、意象和原文的意义之间做出选择。
+本书收录了每首诗的多种版本，并附有关于作者、时代以及诗中地名的注释。
+读者在查找一首诗的时候，往往只记得其中的一句话，或者一条河、一座山的名字。
+我们感谢检查人名拼写的图书馆员和志愿者。`,
+
+	"ja": `床前の月光を見て、地上の霜かと疑う。頭を挙げて山の月を望み、頭を低れて故郷を思う。
+春眠暁を覚えず、処処に啼鳥を聞く。夜来風雨の声、花落つること知る多少ぞ。
+国破れて山河在り、城春にして草木深し。時に感じては花にも涙を濺ぎ、別れを恨んでは鳥にも心を驚かす。
+学びて時に之を習う、亦た説ばしからずや。朋有り遠方より来たる、亦た楽しからずや。
+この詩は詩人が旅の途中で書いたもので、今でも多くの子どもたちが最初に覚える詩です。
+古典の詩の翻訳はいつも正確ではありません。翻訳者はそれぞれ、音と情景と元の意味のどれかを選ばなければならないからです。
+この本には、それぞれの詩のいくつかの訓読と現代語訳を収め、作者や時代、詩に出てくる地名についての注を付けました。
+読者が詩を探すとき、一つの句や、川や山の名前しか覚えていないことがよくあります。
+名前の読み方を確かめてくださった図書館の皆さんとボランティアの方々に感謝します。`,
+
+	"ko": `침대 앞의 밝은 달빛을 보니 땅 위에 서리가 내린 것 같다. 고개를 들어 밝은 달을 바라보고 고개를 숙여 고향을 생각한다.
+이 시는 시인이 여행 중에 쓴 것으로 지금도 많은 아이들이 처음 배우는 시이다.
+고전 시의 번역은 결코 정확하지 않다. 번역자는 소리와 이미지와 원래의 뜻 가운데 하나를 골라야 하기 때문이다.
+이 책에는 각 시의 여러 번역과 함께 작가와 시대, 시에 나오는 지명에 대한 주석을 실었다.
+이름의 표기를 확인해 준 사서와 자원봉사자들에게 감사드린다.`,
+}
@@ -0,0 +1,248 @@
+//go:build ignore
+// +build ignore
+
+// maketables generates the character tables embedded in this package
+// from the ICU transforms shipped with the uconv command.
+//
+//	go run maketables.go
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os/exec"
+	"sort"
+	"strings"
+)
+
+// hanRange cjk unified ideographs of the basic multilingual plane
+var hanRange = [2]rune{0x4E00, 0x9FFF}
+
+// hanExtARange cjk unified ideographs extension a
+var hanExtARange = [2]rune{0x3400, 0x4DBF}
+
+func main() {
+	writePinyinTable("pinyinTable.go")
+	writeSimplifiedTable("simplifiedTable.go")
+	writeNormalizerTables("normalizerTable.go")
+}
+
+// transform run every rune through an ICU transform, one rune per line
+func transform(id string, runes []rune) []string {
+	texts := []string{}
+	for _, r := range runes {
+		texts = append(texts, string(r))
+	}
+	return transformStrings(id, texts)
+}
+
+// transformStrings run every text through an ICU transform, one text per line
+func transformStrings(id string, texts []string) []string {
+	var in bytes.Buffer
+	for _, text := range texts {
+		in.WriteString(text)
+		in.WriteByte('\n')
+	}
+	cmd := exec.Command("uconv", "-x", id)
+	cmd.Stdin = &in
+	out, err := cmd.Output()
+	if err != nil {
+		log.Fatalf("uconv -x %s: %v", id, err)
+	}
+
+	lines := []string{}
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		lines = append(lines, strings.TrimSuffix(scanner.Text(), "\r"))
+	}
+	if len(lines) != len(texts) {
+		log.Fatalf("uconv -x %s: %d lines for %d texts", id, len(lines), len(texts))
+	}
+	return lines
+}
+
+// table a string constant of a generated file
+type table struct {
+	name    string
+	comment string
+	lines   []string
+}
+
+// writeTable write a generated go file holding one string constant
+func writeTable(fileName, source, comment, name string, lines []string) {
+	writeTables(fileName, source, []table{{name: name, comment: comment, lines: lines}})
+}
+
+// writeTables write a generated go file holding string constants
+func writeTables(fileName, source string, tables []table) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by go run maketables.go; DO NOT EDIT.\n")
+	fmt.Fprintf(&b, "// Source: ICU %s.\n\n", source)
+	fmt.Fprintf(&b, "package core\n")
+	for _, t := range tables {
+		fmt.Fprintf(&b, "\n// %s %s\n", t.name, t.comment)
+		fmt.Fprintf(&b, "const %s = \"\" +\n", t.name)
+		for i, line := range t.lines {
+			end := " +"
+			if i == len(t.lines)-1 {
+				end = ""
+			}
+			fmt.Fprintf(&b, "\t%q%s\n", line+"\n", end)
+		}
+	}
+	err := ioutil.WriteFile(fileName, b.Bytes(), 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// group join entries, n to a line
+func group(entries []string, n int, sep string) []string {
+	lines := []string{}
+	for i := 0; i < len(entries); i += n {
+		end := i + n
+		if end > len(entries) {
+			end = len(entries)
+		}
+		lines = append(lines, strings.Join(entries[i:end], sep))
+	}
+	return lines
+}
+
+// writePinyinTable readings of every han rune, grouped by reading
+func writePinyinTable(fileName string) {
+	runes := []rune{}
+	for r := hanRange[0]; r <= hanRange[1]; r++ {
+		runes = append(runes, r)
+	}
+	readings := transform("Han-Latin", runes)
+
+	byReading := map[string][]rune{}
+	for i, reading := range readings {
+		if reading == string(runes[i]) { // no reading known
+			continue
+		}
+		byReading[reading] = append(byReading[reading], runes[i])
+	}
+
+	keys := []string{}
+	for reading := range byReading {
+		keys = append(keys, reading)
+	}
+	sort.Strings(keys)
+
+	lines := []string{}
+	for _, reading := range keys {
+		lines = append(lines, reading+" "+string(byReading[reading]))
+	}
+	writeTable(fileName, "Han-Latin transform", "one reading per line followed by the runes read that way", "pinyinData", lines)
+}
+
+// writeSimplifiedTable traditional runes followed by their simplified form
+func writeSimplifiedTable(fileName string) {
+	runes := []rune{}
+	for _, rng := range [][2]rune{hanExtARange, hanRange} {
+		for r := rng[0]; r <= rng[1]; r++ {
+			runes = append(runes, r)
+		}
+	}
+	simplified := transform("Traditional-Simplified", runes)
+
+	lines := []string{}
+	var line strings.Builder
+	pairs := 0
+	for i, s := range simplified {
+		to := []rune(s)
+		if len(to) != 1 || to[0] == runes[i] {
+			continue
+		}
+		line.WriteRune(runes[i])
+		line.WriteRune(to[0])
+		pairs = pairs + 1
+		if pairs%32 == 0 {
+			lines = append(lines, line.String())
+			line.Reset()
+		}
+	}
+	if line.Len() > 0 {
+		lines = append(lines, line.String())
+	}
+	writeTable(fileName, "Traditional-Simplified transform", "pairs of a traditional rune and its simplified rune", "simplifiedData", lines)
+}
+
+// writeNormalizerTables nfkc mappings, canonical compositions and ascii foldings
+func writeNormalizerTables(fileName string) {
+	runes := []rune{}
+	for r := rune(0xA0); r <= 0xFFFF; r++ {
+		if r >= 0xD800 && r <= 0xDFFF || r >= 0xAC00 && r <= 0xD7A3 || r == 0x2028 || r == 0x2029 {
+			continue // surrogates, hangul syllables, line separators
+		}
+		runes = append(runes, r)
+	}
+
+	nfkc := transform("Any-NFKC", runes)
+	nfkcEntries := []string{}
+	for i, to := range nfkc {
+		if to != string(runes[i]) {
+			nfkcEntries = append(nfkcEntries, string(runes[i])+to)
+		}
+	}
+
+	// pairs that compose canonically, a decomposition of two runes that composes back
+	nfd := transform("Any-NFD", runes)
+	pairs := []string{}
+	composed := []rune{}
+	for i, d := range nfd {
+		if len([]rune(d)) == 2 {
+			pairs = append(pairs, d)
+			composed = append(composed, runes[i])
+		}
+	}
+	nfc := transformStrings("Any-NFC", pairs)
+	composeEntries := []string{}
+	for i, c := range nfc {
+		if c == string(composed[i]) { // not excluded from composition
+			composeEntries = append(composeEntries, pairs[i]+c)
+		}
+	}
+
+	latin := []rune{}
+	for _, rng := range [][2]rune{
+		{0x00A0, 0x02AF}, {0x1D00, 0x1DBF}, {0x1E00, 0x1EFF}, {0x2000, 0x209F},
+		{0x2100, 0x214F}, {0x2460, 0x24FF}, {0x2C60, 0x2C7F}, {0xA720, 0xA7FF},
+		{0xFB00, 0xFB06}, {0xFF01, 0xFF5E},
+	} {
+		for r := rng[0]; r <= rng[1]; r++ {
+			if r != 0x2028 && r != 0x2029 {
+				latin = append(latin, r)
+			}
+		}
+	}
+	ascii := transform("Latin-ASCII", latin)
+	asciiEntries := []string{}
+	for i, to := range ascii {
+		if to == string(latin[i]) || len(to) == 0 || !isASCII(to) {
+			continue
+		}
+		asciiEntries = append(asciiEntries, string(latin[i])+to)
+	}
+
+	writeTables(fileName, "Any-NFKC, Any-NFD and Latin-ASCII transforms", []table{
+		{name: "nfkcData", comment: "one rune per entry followed by its nfkc form", lines: group(nfkcEntries, 16, "\n")},
+		{name: "composeData", comment: "two runes per entry followed by their canonical composition", lines: group(composeEntries, 16, "\n")},
+		{name: "asciiFoldingData", comment: "one rune per entry followed by its ascii form", lines: group(asciiEntries, 16, "\n")},
+	})
+}
+
+// isASCII all bytes are ascii
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
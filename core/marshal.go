@@ -0,0 +1,500 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+Marshal builds a Document from a struct, Unmarshal fills a struct from the stored fields of a Document.
+The fields of the struct are mapped by their poetry tag, the field name, the kind and options:
+
+	type Poem struct {
+		Title      string    `poetry:"title,text,stored"`
+		Author     string    `poetry:"author,keyword,stored,sortable"`
+		Paragraphs []string  `poetry:"paragraphs,text,stored"`
+		Year       int       `poetry:"year,int,stored,sortable"`
+		Added      time.Time `poetry:"added,time,sortable"`
+		Cover      []byte    `poetry:"cover,binary,stored"`
+		Notes      Notes     `poetry:"notes"`
+		Draft      string    `poetry:"-"`
+	}
+
+The kinds are text (tokenized), keyword, int, float, bool, time and binary,
+the kind follows from the Go type when it is left out, text for a string.
+Numbers, bools and times are indexed as keywords of their text, times in RFC 3339 format.
+The options are
+
+	stored    the value is stored, only stored values come back with Unmarshal
+	sortable  the value is also kept in doc values of the same name,
+	          sorted for text, keyword and bool, sorted set for a slice of them,
+	          numeric for int, float (FloatToSortableInt64) and time (unix nanoseconds), binary for binary
+	noindex   the value is not indexed
+
+A slice is a multi-valued field, a nested struct is flattened with dotted names (notes.source),
+an embedded struct without a tag is flattened without a prefix.
+Nil pointers and unexported fields are left out, a field without a tag is mapped by its Go name.
+*/
+
+// fieldTag parsed poetry tag of a struct field
+type fieldTag struct {
+	name     string
+	kind     string
+	stored   bool
+	sortable bool
+	noIndex  bool
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// Marshal document of a struct or a pointer to a struct
+func Marshal(v interface{}) (Document, error) {
+	doc := Document{}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return doc, fmt.Errorf("marshal of %T, want a struct", v)
+	}
+	err := marshalStruct(&doc, "", rv)
+	return doc, err
+}
+
+// Unmarshal fill the struct v points to from the stored fields of doc
+func Unmarshal(doc Document, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("unmarshal into %T, want a pointer to a struct", v)
+	}
+	return unmarshalStruct(doc, "", rv.Elem())
+}
+
+// FloatToSortableInt64 int64 in the order of the floats, for numeric doc values
+func FloatToSortableInt64(f float64) int64 {
+	bits := int64(math.Float64bits(f))
+	if bits < 0 {
+		bits = bits ^ math.MaxInt64
+	}
+	return bits
+}
+
+// SortableInt64ToFloat float of FloatToSortableInt64
+func SortableInt64ToFloat(n int64) float64 {
+	if n < 0 {
+		n = n ^ math.MaxInt64
+	}
+	return math.Float64frombits(uint64(n))
+}
+
+// parseFieldTag tag of a struct field, skip for "-"
+func parseFieldTag(sf reflect.StructField) (fieldTag, bool, error) {
+	tag := fieldTag{name: sf.Name}
+	value, found := sf.Tag.Lookup("poetry")
+	if value == "-" {
+		return tag, true, nil
+	}
+	if !found {
+		return tag, false, nil
+	}
+	parts := strings.Split(value, ",")
+	if parts[0] != "" {
+		tag.name = parts[0]
+	}
+	for i, part := range parts[1:] {
+		switch part {
+		case "stored":
+			tag.stored = true
+		case "sortable":
+			tag.sortable = true
+		case "noindex":
+			tag.noIndex = true
+		case "text", "keyword", "int", "float", "bool", "time", "binary":
+			if i != 0 {
+				return tag, false, fmt.Errorf("field %s: kind %s after the options", sf.Name, part)
+			}
+			tag.kind = part
+		default:
+			return tag, false, fmt.Errorf("field %s: unknown poetry tag option %q", sf.Name, part)
+		}
+	}
+	return tag, false, nil
+}
+
+// isNestedStruct a struct flattened into dotted fields
+func isNestedStruct(t reflect.Type) bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct && t != timeType
+}
+
+// isMultiValued a slice of values, not bytes
+func isMultiValued(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() != reflect.Uint8
+}
+
+// valueKind kind of a tag checked against a go type, or the default kind of the type
+func valueKind(name string, kind string, t reflect.Type) (string, error) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	var want string
+	switch t.Kind() {
+	case reflect.String:
+		want = "text"
+		if kind == "keyword" {
+			want = kind
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		want = "int"
+	case reflect.Float32, reflect.Float64:
+		want = "float"
+	case reflect.Bool:
+		want = "bool"
+	case reflect.Struct:
+		if t == timeType {
+			want = "time"
+		}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			want = "binary"
+		}
+	}
+	if want == "" {
+		return "", fmt.Errorf("field %s: unsupported type %s", name, t)
+	}
+	if kind != "" && kind != want {
+		return "", fmt.Errorf("field %s: kind %s of a %s", name, kind, t)
+	}
+	return want, nil
+}
+
+// ================================Marshal=======================================
+
+// marshalStruct add the fields of a struct, names prefixed by prefix
+func marshalStruct(doc *Document, prefix string, v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" && !(sf.Anonymous && isNestedStruct(sf.Type)) { // unexported
+			continue
+		}
+		tag, skip, err := parseFieldTag(sf)
+		if err != nil {
+			return err
+		}
+		if skip {
+			continue
+		}
+		name := prefix + tag.name
+
+		fv := v.Field(i)
+		for fv.Kind() == reflect.Ptr && !fv.IsNil() {
+			fv = fv.Elem()
+		}
+		if fv.Kind() == reflect.Ptr { // nil
+			continue
+		}
+
+		if isNestedStruct(fv.Type()) {
+			if tag.kind != "" {
+				return fmt.Errorf("field %s: kind %s of a struct", name, tag.kind)
+			}
+			nestedPrefix := name + "."
+			if _, tagged := sf.Tag.Lookup("poetry"); sf.Anonymous && !tagged {
+				nestedPrefix = prefix
+			}
+			err = marshalStruct(doc, nestedPrefix, fv)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if isMultiValued(fv.Type()) {
+			if isNestedStruct(fv.Type().Elem()) {
+				return fmt.Errorf("field %s: slice of structs", name)
+			}
+			tag.kind, err = valueKind(name, tag.kind, fv.Type().Elem())
+			if err != nil {
+				return err
+			}
+			for k := 0; k < fv.Len(); k++ {
+				err = marshalValue(doc, name, tag, fv.Index(k), true)
+				if err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		tag.kind, err = valueKind(name, tag.kind, fv.Type())
+		if err != nil {
+			return err
+		}
+		err = marshalValue(doc, name, tag, fv, false)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// marshalValue add the fields of a value, one of the values of a slice when multi
+func marshalValue(doc *Document, name string, tag fieldTag, v reflect.Value, multi bool) error {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	var (
+		text    string
+		numeric int64
+	)
+	switch tag.kind {
+	case "text", "keyword":
+		text = v.String()
+	case "int":
+		if v.Kind() >= reflect.Uint && v.Kind() <= reflect.Uint64 {
+			u := v.Uint()
+			if tag.sortable && u > math.MaxInt64 {
+				return fmt.Errorf("field %s: %d overflows numeric doc values", name, u)
+			}
+			text = strconv.FormatUint(u, 10)
+			numeric = int64(u)
+		} else {
+			numeric = v.Int()
+			text = strconv.FormatInt(numeric, 10)
+		}
+	case "float":
+		f := v.Float()
+		text = strconv.FormatFloat(f, 'g', -1, v.Type().Bits())
+		numeric = FloatToSortableInt64(f)
+	case "bool":
+		text = strconv.FormatBool(v.Bool())
+	case "time":
+		tm := v.Interface().(time.Time)
+		text = tm.Format(time.RFC3339Nano)
+		numeric = tm.UnixNano()
+	case "binary":
+		return marshalBinary(doc, name, tag, v.Bytes(), multi)
+	}
+
+	if !tag.noIndex || tag.stored {
+		doc.Add(Field{
+			name:        name,
+			value:       text,
+			isStored:    tag.stored,
+			isIndexed:   !tag.noIndex,
+			isTokenized: tag.kind == "text",
+		})
+	}
+	if !tag.sortable {
+		return nil
+	}
+
+	var (
+		dv  Field
+		err error
+	)
+	switch {
+	case (tag.kind == "text" || tag.kind == "keyword" || tag.kind == "bool") && multi:
+		dv, err = SortedSetDocValuesField(name, text)
+	case tag.kind == "text" || tag.kind == "keyword" || tag.kind == "bool":
+		dv, err = SortedDocValuesField(name, text)
+	case multi:
+		return fmt.Errorf("field %s: sortable slice of %s", name, tag.kind)
+	default:
+		dv, err = NumericDocValuesField(name, numeric)
+	}
+	if err != nil {
+		return err
+	}
+	return doc.Add(dv)
+}
+
+// marshalBinary add the fields of a []byte value
+func marshalBinary(doc *Document, name string, tag fieldTag, b []byte, multi bool) error {
+	if !tag.stored && !tag.sortable {
+		return fmt.Errorf("field %s: binary neither stored nor sortable", name)
+	}
+	if tag.sortable && multi {
+		return fmt.Errorf("field %s: sortable slice of binary", name)
+	}
+	if tag.stored {
+		f, err := Binary(name, b)
+		if err != nil {
+			return err
+		}
+		doc.Add(f)
+	}
+	if tag.sortable {
+		f, err := BinaryDocValuesField(name, b)
+		if err != nil {
+			return err
+		}
+		doc.Add(f)
+	}
+	return nil
+}
+
+// ================================Unmarshal=======================================
+
+// unmarshalStruct fill the fields of a struct, names prefixed by prefix
+func unmarshalStruct(doc Document, prefix string, v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" && !(sf.Anonymous && isNestedStruct(sf.Type)) { // unexported
+			continue
+		}
+		tag, skip, err := parseFieldTag(sf)
+		if err != nil {
+			return err
+		}
+		if skip {
+			continue
+		}
+		name := prefix + tag.name
+		fv := v.Field(i)
+
+		if isNestedStruct(fv.Type()) {
+			nestedPrefix := name + "."
+			if _, tagged := sf.Tag.Lookup("poetry"); sf.Anonymous && !tagged {
+				nestedPrefix = prefix
+			}
+			if fv.Kind() == reflect.Ptr {
+				if !hasFieldPrefix(doc, nestedPrefix) {
+					continue
+				}
+				if !fv.CanSet() {
+					return fmt.Errorf("field %s: unexported embedded pointer", name)
+				}
+				fv = allocate(fv)
+			}
+			err = unmarshalStruct(doc, nestedPrefix, fv)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		fields := storedFields(doc, name)
+		if len(fields) == 0 || !fv.CanSet() {
+			continue
+		}
+
+		if isMultiValued(fv.Type()) {
+			tag.kind, err = valueKind(name, tag.kind, fv.Type().Elem())
+			if err != nil {
+				return err
+			}
+			slice := reflect.MakeSlice(fv.Type(), len(fields), len(fields))
+			for k, field := range fields {
+				err = unmarshalValue(name, tag.kind, field, slice.Index(k))
+				if err != nil {
+					return err
+				}
+			}
+			fv.Set(slice)
+			continue
+		}
+
+		tag.kind, err = valueKind(name, tag.kind, fv.Type())
+		if err != nil {
+			return err
+		}
+		err = unmarshalValue(name, tag.kind, fields[0], fv)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storedFields value fields of a name, without doc values
+func storedFields(doc Document, name string) []Field {
+	fields := []Field{}
+	for _, field := range doc.Fields {
+		if field.name == name && field.docValuesType == DocValuesNone {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// hasFieldPrefix doc has a field whose name starts with prefix
+func hasFieldPrefix(doc Document, prefix string) bool {
+	for _, field := range doc.Fields {
+		if strings.HasPrefix(field.name, prefix) && field.docValuesType == DocValuesNone {
+			return true
+		}
+	}
+	return false
+}
+
+// allocate the value of a pointer, allocated when nil
+func allocate(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+// unmarshalValue set v from a field
+func unmarshalValue(name string, kind string, field Field, v reflect.Value) error {
+	v = allocate(v)
+	text := field.value
+	switch kind {
+	case "text", "keyword":
+		v.SetString(text)
+	case "int":
+		if v.Kind() >= reflect.Uint && v.Kind() <= reflect.Uint64 {
+			u, err := strconv.ParseUint(text, 10, v.Type().Bits())
+			if err != nil {
+				return fmt.Errorf("field %s: %v", name, err)
+			}
+			v.SetUint(u)
+		} else {
+			n, err := strconv.ParseInt(text, 10, v.Type().Bits())
+			if err != nil {
+				return fmt.Errorf("field %s: %v", name, err)
+			}
+			v.SetInt(n)
+		}
+	case "float":
+		f, err := strconv.ParseFloat(text, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("field %s: %v", name, err)
+		}
+		v.SetFloat(f)
+	case "bool":
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return fmt.Errorf("field %s: %v", name, err)
+		}
+		v.SetBool(b)
+	case "time":
+		tm, err := time.Parse(time.RFC3339Nano, text)
+		if err != nil {
+			return fmt.Errorf("field %s: %v", name, err)
+		}
+		v.Set(reflect.ValueOf(tm))
+	case "binary":
+		b := make([]byte, len(field.binary))
+		copy(b, field.binary)
+		v.SetBytes(b)
+	}
+	return nil
+}
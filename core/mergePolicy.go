@@ -0,0 +1,347 @@
+package core
+
+import (
+	"math"
+	"os"
+	"sort"
+)
+
+/*
+A MergePolicy chooses which segments of an index the writer merges, after a new segment is written.
+The writer asks it again after each merge, until it finds no more merges.
+A merge of a segment already merging, see SegmentInfos.Merging, is dropped.
+
+LogDocMergePolicy merges MergeFactor segments of about the same number of documents into one,
+so segments come in levels, each MergeFactor times larger than the level below.
+LogByteSizeMergePolicy does the same by the bytes of the segments instead of their documents,
+so a few large documents are not merged as often as many small ones.
+Both only merge adjacent segments, the documents keep their order.
+
+TieredMergePolicy allows SegmentsPerTier segments per tier of size, and merges segments of similar size, adjacent or not,
+preferring merges of small and even segments, and of segments with many deleted documents, whose merge reclaims them.
+It finds several merges at once, of the segments not merging yet, so a ConcurrentMergeScheduler runs them together.
+Segments larger than half of MaxMergedSegmentBytes are left alone.
+A smaller MergeFactor or SegmentsPerTier keeps fewer segments for faster searches, at the cost of more merging.
+*/
+
+// MergePolicy chooses the merges of segments
+type MergePolicy interface {
+	FindMerges(infos *SegmentInfos) (*MergeSpecification, error) // nil for no merge
+}
+
+// OneMerge segments merged into one
+type OneMerge struct {
+	Segments []SegmentInfo
+
+	rateLimit float64 // bytes written per second, 0 for no limit
+	aborted   int32   // set atomically by Abort
+}
+
+// MergeSpecification merges to do
+type MergeSpecification struct {
+	Merges []OneMerge
+}
+
+// LogDocMergePolicy merge levels of segments by documents
+type LogDocMergePolicy struct {
+	MergeFactor  int64 // segments merged at once, at least 2
+	MaxMergeDocs int64 // largest number of documents of a merged segment
+}
+
+// LogByteSizeMergePolicy merge levels of segments by bytes
+type LogByteSizeMergePolicy struct {
+	MergeFactor   int64 // segments merged at once, at least 2
+	MinMergeBytes int64 // smaller segments count as this size, all in the lowest level
+	MaxMergeBytes int64 // largest size of a merged segment
+}
+
+// TieredMergePolicy merge segments of similar size, with a budget of segments per tier
+type TieredMergePolicy struct {
+	SegmentsPerTier       float64 // segments allowed per tier
+	MaxMergeAtOnce        int     // segments merged at once
+	MaxMergedSegmentBytes int64   // largest size of a merged segment
+	FloorSegmentBytes     int64   // smaller segments count as this size
+}
+
+// NewLogDocMergePolicy log doc merge policy merging 10 segments at once, with no limit of documents.
+// With smaller merge factors, searches on unoptimized indexes are faster, but indexing is slower,
+// larger ones (> 10) are best for batched index creation, smaller ones (< 10) for indexes interactively maintained.
+// A small MaxMergeDocs (e.g., less than 10,000) limits the pauses of interactive indexing to a few seconds.
+func NewLogDocMergePolicy() *LogDocMergePolicy {
+	return &LogDocMergePolicy{
+		MergeFactor:  10,
+		MaxMergeDocs: math.MaxInt64,
+	}
+}
+
+// NewLogByteSizeMergePolicy log byte size merge policy, merging up to 2GB segments
+func NewLogByteSizeMergePolicy() *LogByteSizeMergePolicy {
+	return &LogByteSizeMergePolicy{
+		MergeFactor:   10,
+		MinMergeBytes: 1600 * 1024,
+		MaxMergeBytes: 2 * 1024 * 1024 * 1024,
+	}
+}
+
+// NewTieredMergePolicy tiered merge policy, merging up to 5GB segments
+func NewTieredMergePolicy() *TieredMergePolicy {
+	return &TieredMergePolicy{
+		SegmentsPerTier:       10,
+		MaxMergeAtOnce:        10,
+		MaxMergedSegmentBytes: 5 * 1024 * 1024 * 1024,
+		FloorSegmentBytes:     2 * 1024 * 1024,
+	}
+}
+
+// ================================SegmentInfo=======================================
+
+// Name name of the segment
+func (si SegmentInfo) Name() string {
+	return si.name
+}
+
+// DocCount number of documents of the segment, deleted or not
+func (si SegmentInfo) DocCount() int64 {
+	return si.docCount
+}
+
+// DelCount number of deleted documents of the segment
+func (si SegmentInfo) DelCount() int64 {
+	return si.delCount
+}
+
+// SizeInBytes size of the files of the segment
+func (si SegmentInfo) SizeInBytes() (int64, error) {
+	files, err := segmentFiles(si.dirPath, si.name)
+	if err != nil {
+		return 0, err
+	}
+	size := int64(0)
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return 0, err
+		}
+		size = size + info.Size()
+	}
+	return size, nil
+}
+
+// ================================SegmentInfos=======================================
+
+// Len number of segments
+func (s *SegmentInfos) Len() int {
+	return len(s.segInfos)
+}
+
+// Merging segment i is merged by a pending or running merge, a new merge must leave it out
+func (s *SegmentInfos) Merging(i int64) bool {
+	return s.merging[s.segInfos[i].name]
+}
+
+// ================================LogDocMergePolicy=======================================
+
+// FindMerges the trailing segments of a level once MergeFactor documents of the level are there
+func (p *LogDocMergePolicy) FindMerges(infos *SegmentInfos) (*MergeSpecification, error) {
+	sizes := make([]int64, len(infos.segInfos))
+	for i, si := range infos.segInfos {
+		sizes[i] = si.docCount
+	}
+	return logMerges(infos, sizes, p.MergeFactor, 1, p.MaxMergeDocs), nil
+}
+
+// ================================LogByteSizeMergePolicy=======================================
+
+// FindMerges the trailing segments of a level once MergeFactor times the size of the level are there
+func (p *LogByteSizeMergePolicy) FindMerges(infos *SegmentInfos) (*MergeSpecification, error) {
+	sizes := make([]int64, len(infos.segInfos))
+	for i, si := range infos.segInfos {
+		size, err := si.SizeInBytes()
+		if err != nil {
+			return nil, err
+		}
+		if size < p.MinMergeBytes {
+			size = p.MinMergeBytes
+		}
+		sizes[i] = size
+	}
+	return logMerges(infos, sizes, p.MergeFactor, p.MinMergeBytes, p.MaxMergeBytes), nil
+}
+
+// logMerges merge of the lowest level, from the smallest, of the trailing segments smaller than the level
+// adding up to the level: minSize times mergeFactor, times mergeFactor again for each next level up to maxSize
+func logMerges(infos *SegmentInfos, sizes []int64, mergeFactor, minSize, maxSize int64) *MergeSpecification {
+	if mergeFactor < 2 || minSize < 1 {
+		return nil
+	}
+	target := minSize * mergeFactor
+	for target <= maxSize {
+		minSegment := len(sizes) - 1
+		mergeSize := int64(0)
+		for minSegment >= 0 && sizes[minSegment] < target {
+			mergeSize = mergeSize + sizes[minSegment]
+			minSegment = minSegment - 1
+		}
+
+		if mergeSize >= target { // found a merge to do
+			segs := make([]SegmentInfo, len(sizes)-minSegment-1)
+			copy(segs, infos.segInfos[minSegment+1:])
+			return &MergeSpecification{Merges: []OneMerge{{Segments: segs}}}
+		}
+		if target > maxSize/mergeFactor {
+			break
+		}
+		target = target * mergeFactor
+	}
+	return nil
+}
+
+// ================================TieredMergePolicy=======================================
+
+// tieredSegment a segment to merge, and its size
+type tieredSegment struct {
+	index    int
+	size     int64 // bytes without the deleted documents
+	fullSize int64 // bytes
+}
+
+// FindMerges the best merges, of the segments not merging, while there are more segments than the tiers allow
+func (p *TieredMergePolicy) FindMerges(infos *SegmentInfos) (*MergeSpecification, error) {
+	if p.MaxMergeAtOnce < 2 || p.SegmentsPerTier < 2 {
+		return nil, nil
+	}
+	eligible := []tieredSegment{}
+	count := 0
+	totalSize := int64(0)
+	minSize := int64(math.MaxInt64)
+	for i, si := range infos.segInfos {
+		fullSize, err := si.SizeInBytes()
+		if err != nil {
+			return nil, err
+		}
+		size := fullSize
+		if si.docCount > 0 {
+			size = int64(float64(fullSize) * float64(si.docCount-si.delCount) / float64(si.docCount))
+		}
+		if size > p.MaxMergedSegmentBytes/2 { // too large to merge
+			continue
+		}
+		count = count + 1
+		totalSize = totalSize + size
+		if size < minSize {
+			minSize = size
+		}
+		if !infos.Merging(int64(i)) {
+			eligible = append(eligible, tieredSegment{index: i, size: size, fullSize: fullSize})
+		}
+	}
+	sort.SliceStable(eligible, func(i, j int) bool {
+		return eligible[i].size > eligible[j].size
+	})
+
+	// segments allowed: SegmentsPerTier per tier, each tier MaxMergeAtOnce times larger
+	levelSize := p.floor(minSize)
+	allowed := 0.0
+	remaining := float64(totalSize)
+	for {
+		levelCount := remaining / float64(levelSize)
+		if levelCount < p.SegmentsPerTier {
+			allowed = allowed + math.Ceil(levelCount)
+			break
+		}
+		allowed = allowed + p.SegmentsPerTier
+		remaining = remaining - p.SegmentsPerTier*float64(levelSize)
+		levelSize = levelSize * int64(p.MaxMergeAtOnce)
+	}
+
+	var spec *MergeSpecification
+	for float64(len(eligible)) > allowed {
+		best := p.bestMerge(eligible)
+		if best == nil {
+			break
+		}
+		sort.Slice(best, func(i, j int) bool {
+			return best[i].index < best[j].index
+		})
+		merged := map[int]bool{}
+		merge := OneMerge{}
+		for _, seg := range best {
+			merged[seg.index] = true
+			merge.Segments = append(merge.Segments, infos.segInfos[seg.index])
+		}
+		if spec == nil {
+			spec = &MergeSpecification{}
+		}
+		spec.Merges = append(spec.Merges, merge)
+
+		rest := []tieredSegment{}
+		for _, seg := range eligible {
+			if !merged[seg.index] {
+				rest = append(rest, seg)
+			}
+		}
+		eligible = rest
+	}
+	return spec, nil
+}
+
+// bestMerge best scored run of MaxMergeAtOnce segments, from the largest, nil when none
+func (p *TieredMergePolicy) bestMerge(segs []tieredSegment) []tieredSegment {
+	var best []tieredSegment
+	bestScore := math.Inf(1)
+	for start := range segs {
+		candidate := []tieredSegment{}
+		mergeSize := int64(0)
+		for _, seg := range segs[start:] {
+			if len(candidate) == p.MaxMergeAtOnce {
+				break
+			}
+			if mergeSize+seg.size > p.MaxMergedSegmentBytes {
+				continue
+			}
+			candidate = append(candidate, seg)
+			mergeSize = mergeSize + seg.size
+		}
+		if len(candidate) < 2 {
+			continue
+		}
+		score := p.score(candidate)
+		if score < bestScore {
+			best = candidate
+			bestScore = score
+		}
+	}
+	return best
+}
+
+// floor size of a segment, at least FloorSegmentBytes
+func (p *TieredMergePolicy) floor(size int64) int64 {
+	if size < p.FloorSegmentBytes {
+		return p.FloorSegmentBytes
+	}
+	if size < 1 {
+		return 1
+	}
+	return size
+}
+
+// score of a merge, lower is better:
+// skew, the part of the largest segment, by a little of the merged size,
+// by the square of the part of the merged bytes kept, so merges reclaiming deleted documents come first
+func (p *TieredMergePolicy) score(candidate []tieredSegment) float64 {
+	size := int64(0)
+	fullSize := int64(0)
+	floorSize := int64(0)
+	for _, seg := range candidate {
+		size = size + seg.size
+		fullSize = fullSize + seg.fullSize
+		floorSize = floorSize + p.floor(seg.size)
+	}
+	skew := float64(p.floor(candidate[0].size)) / float64(floorSize)
+	score := skew * math.Pow(float64(size), 0.05)
+	if fullSize > 0 {
+		kept := float64(size) / float64(fullSize)
+		score = score * kept * kept
+	}
+	return score
+}
@@ -0,0 +1,174 @@
+package core
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+A MergeScheduler runs the merges a writer has pending, the merges its MergePolicy found.
+
+SerialMergeScheduler runs them one at a time, in the goroutine that wrote the segment triggering them,
+so an AddDocument may wait for a large merge.
+ConcurrentMergeScheduler runs up to MaxThreadCount merges in background goroutines, AddDocument does not wait for them,
+and limits the bytes written by each merge to MaxMergeBytesPerSec, so merges leave IO to indexing and searches.
+Close of the writer waits for the running merges, CloseAbortingMerges stops them.
+*/
+
+// ErrMergeAborted error of a merge aborted by its writer
+var ErrMergeAborted = errors.New("merge aborted")
+
+// MergeScheduler runs the merges of a writer
+type MergeScheduler interface {
+	Merge(source MergeSource) error // run the pending merges of source
+	Close() error                   // wait for the running merges
+}
+
+// MergeSource pending merges of a writer
+type MergeSource interface {
+	NextMerge() *OneMerge        // next pending merge, nil when none
+	Merge(merge *OneMerge) error // run a merge
+}
+
+// SerialMergeScheduler merges one at a time in the calling goroutine
+type SerialMergeScheduler struct {
+	mu sync.Mutex
+}
+
+// ConcurrentMergeScheduler merges in background goroutines
+type ConcurrentMergeScheduler struct {
+	MaxThreadCount      int     // merges running at once
+	MaxMergeBytesPerSec float64 // bytes written per second by a merge, 0 for no limit
+
+	mu      sync.Mutex
+	running int
+	wg      sync.WaitGroup
+	err     error // first failure of a merge
+}
+
+// NewConcurrentMergeScheduler concurrent scheduler of half the cpus, 1 to 4 merges at once, not throttled
+func NewConcurrentMergeScheduler() *ConcurrentMergeScheduler {
+	n := runtime.NumCPU() / 2
+	if n < 1 {
+		n = 1
+	} else if n > 4 {
+		n = 4
+	}
+	return &ConcurrentMergeScheduler{MaxThreadCount: n}
+}
+
+// ================================OneMerge=======================================
+
+// SetRateLimit limit the bytes written per second by the merge, 0 for no limit
+func (m *OneMerge) SetRateLimit(bytesPerSec float64) {
+	m.rateLimit = bytesPerSec
+}
+
+// Abort stop the merge at its next step
+func (m *OneMerge) Abort() {
+	atomic.StoreInt32(&m.aborted, 1)
+}
+
+// Aborted the merge was aborted
+func (m *OneMerge) Aborted() bool {
+	return atomic.LoadInt32(&m.aborted) == 1
+}
+
+// progress check of a running merge writing a segment, stopping when aborted and pausing to keep to the rate limit
+func (m *OneMerge) progress(seg SegmentInfo) func() error {
+	start := time.Now()
+	steps := 0
+	return func() error {
+		if m.Aborted() {
+			return ErrMergeAborted
+		}
+		steps = steps + 1
+		if m.rateLimit <= 0 || steps%64 != 1 { // the first step, then every 64
+			return nil
+		}
+		written, err := seg.SizeInBytes()
+		if err != nil {
+			return err
+		}
+		for {
+			wait := time.Duration(float64(written)/m.rateLimit*float64(time.Second)) - time.Since(start)
+			if wait <= 0 {
+				return nil
+			}
+			if wait > 100*time.Millisecond { // check for abort while waiting
+				wait = 100 * time.Millisecond
+			}
+			time.Sleep(wait)
+			if m.Aborted() {
+				return ErrMergeAborted
+			}
+		}
+	}
+}
+
+// ================================SerialMergeScheduler=======================================
+
+// Merge run the pending merges, one after the other
+func (s *SerialMergeScheduler) Merge(source MergeSource) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for merge := source.NextMerge(); merge != nil; merge = source.NextMerge() {
+		err := source.Merge(merge)
+		if err != nil && err != ErrMergeAborted {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close nothing runs after Merge returns
+func (s *SerialMergeScheduler) Close() error {
+	return nil
+}
+
+// ================================ConcurrentMergeScheduler=======================================
+
+// Merge start pending merges in the background, up to MaxThreadCount running
+func (c *ConcurrentMergeScheduler) Merge(source MergeSource) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for c.running < c.MaxThreadCount || c.running == 0 {
+		merge := source.NextMerge()
+		if merge == nil {
+			break
+		}
+		merge.SetRateLimit(c.MaxMergeBytesPerSec)
+		c.running = c.running + 1
+		c.wg.Add(1)
+		go c.run(source, merge)
+	}
+	return nil
+}
+
+// run run a merge, then the merges pending by then
+func (c *ConcurrentMergeScheduler) run(source MergeSource, merge *OneMerge) {
+	defer c.wg.Done()
+	err := source.Merge(merge)
+
+	c.mu.Lock()
+	c.running = c.running - 1
+	if err != nil && err != ErrMergeAborted && c.err == nil {
+		c.err = err
+	}
+	c.mu.Unlock()
+
+	c.Merge(source)
+}
+
+// Close wait for the running merges, and return the first failure of a merge since the last Close
+func (c *ConcurrentMergeScheduler) Close() error {
+	c.wg.Wait()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	err := c.err
+	c.err = nil
+	return err
+}
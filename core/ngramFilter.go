@@ -0,0 +1,151 @@
+package core
+
+import (
+	"fmt"
+)
+
+/*
+An NGramTokenFilter emits the n-grams of each token,
+for every n between minGram and maxGram, counted in runes.
+
+Grams are ordered by start rune, then by size,
+they all keep the offsets of the token they came from,
+and all but the first are stacked at the position of the token.
+Tokens shorter than minGram are dropped unless preserveOriginal is set,
+in which case the token itself is emitted along with its grams.
+*/
+
+// NGramTokenFilter n-gram token filter
+type NGramTokenFilter struct {
+	TokenFilter
+	minGram          int
+	maxGram          int
+	edgesOnly        bool     // only grams starting at the first rune
+	preserveOriginal bool     // also emit the whole token
+	emitted          []*Token // grams ready to be returned
+	skippedInc       int64    // increments of dropped tokens
+}
+
+/*
+An EdgeNGramTokenFilter emits the prefixes of each token
+between minGram and maxGram runes long.
+
+Indexing edge n-grams lets a plain term lookup answer a prefix,
+e.g. the title 静夜思 is found by 静, 静夜 and 静夜思
+without scanning the term dictionary.
+*/
+
+// EdgeNGramTokenFilter edge n-gram token filter
+type EdgeNGramTokenFilter struct {
+	NGramTokenFilter
+}
+
+// NewNGramTokenFilter new n-gram filter
+func NewNGramTokenFilter(input TokenStream, minGram, maxGram int, preserveOriginal bool) (*NGramTokenFilter, error) {
+	err := checkGramSizes(minGram, maxGram)
+	if err != nil {
+		return nil, err
+	}
+	return &NGramTokenFilter{
+		TokenFilter:      TokenFilter{input: input},
+		minGram:          minGram,
+		maxGram:          maxGram,
+		preserveOriginal: preserveOriginal,
+	}, nil
+}
+
+// NewEdgeNGramTokenFilter new edge n-gram filter
+func NewEdgeNGramTokenFilter(input TokenStream, minGram, maxGram int, preserveOriginal bool) (*EdgeNGramTokenFilter, error) {
+	nf, err := NewNGramTokenFilter(input, minGram, maxGram, preserveOriginal)
+	if err != nil {
+		return nil, err
+	}
+	nf.edgesOnly = true
+	return &EdgeNGramTokenFilter{*nf}, nil
+}
+
+// NGramFilterFactory n-gram filter for an analyzer chain
+func NGramFilterFactory(minGram, maxGram int, preserveOriginal bool) (TokenFilterFactory, error) {
+	err := checkGramSizes(minGram, maxGram)
+	if err != nil {
+		return nil, err
+	}
+	return func(input TokenStream) TokenStream {
+		nf, _ := NewNGramTokenFilter(input, minGram, maxGram, preserveOriginal)
+		return nf
+	}, nil
+}
+
+// EdgeNGramFilterFactory edge n-gram filter for an analyzer chain
+func EdgeNGramFilterFactory(minGram, maxGram int, preserveOriginal bool) (TokenFilterFactory, error) {
+	err := checkGramSizes(minGram, maxGram)
+	if err != nil {
+		return nil, err
+	}
+	return func(input TokenStream) TokenStream {
+		ef, _ := NewEdgeNGramTokenFilter(input, minGram, maxGram, preserveOriginal)
+		return ef
+	}, nil
+}
+
+// checkGramSizes gram sizes must be positive and ordered
+func checkGramSizes(minGram, maxGram int) error {
+	if minGram < 1 {
+		return fmt.Errorf("minGram must be greater than zero")
+	}
+	if minGram > maxGram {
+		return fmt.Errorf("minGram must not be greater than maxGram")
+	}
+	return nil
+}
+
+// ================================NGramTokenFilter=======================================
+
+// Next next gram
+func (nf *NGramTokenFilter) Next() (*Token, error) {
+	for len(nf.emitted) == 0 {
+		t, err := nf.input.Next()
+		if t == nil || err != nil {
+			return t, err
+		}
+		nf.grams(t)
+	}
+
+	t := nf.emitted[0]
+	nf.emitted = nf.emitted[1:]
+	return t, nil
+}
+
+// grams split a token into grams
+func (nf *NGramTokenFilter) grams(t *Token) {
+	runes := []rune(t.TermText)
+	inc := t.PositionIncrement + nf.skippedInc
+
+	add := func(text string) {
+		g := newToken(text, t.StartOffset, t.EndOffset, t.Type)
+		g.PositionIncrement = 0
+		if len(nf.emitted) == 0 {
+			g.PositionIncrement = inc
+		}
+		nf.emitted = append(nf.emitted, g)
+	}
+
+	lastStart := len(runes) - nf.minGram
+	if nf.edgesOnly && lastStart > 0 {
+		lastStart = 0
+	}
+	for start := 0; start <= lastStart; start++ {
+		for n := nf.minGram; n <= nf.maxGram && start+n <= len(runes); n++ {
+			add(string(runes[start : start+n]))
+		}
+	}
+	if nf.preserveOriginal && (len(runes) < nf.minGram || len(runes) > nf.maxGram) {
+		add(t.TermText)
+	}
+
+	if len(nf.emitted) == 0 { // token dropped, keep its increment
+		nf.skippedInc = inc
+	} else {
+		nf.skippedInc = 0
+	}
+}
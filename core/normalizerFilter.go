@@ -0,0 +1,204 @@
+package core
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+An ICUNormalizerFilter normalizes token text to NFKC and folds its case,
+as ICU's nfkc_cf normalizer does,
+so that "Ｌｉ Ｂａｉ" is indexed and searched as "li bai".
+
+Full-width and half-width forms become their ordinary forms,
+compatibility ideographs their unified ideographs,
+ligatures and other compatibility characters their plain spelling,
+and a letter followed by a combining mark is composed when a precomposed letter exists.
+The tables are generated from ICU and cover the basic multilingual plane.
+*/
+
+// ICUNormalizerFilter nfkc and case folding filter
+type ICUNormalizerFilter struct {
+	TokenFilter
+}
+
+/*
+An ASCIIFoldingFilter replaces letters, digits and symbols outside of ASCII
+by their ASCII equivalents, when one exists,
+e.g. "Dù Fǔ" becomes "Du Fu", "æ" becomes "ae" and "ß" becomes "ss".
+With preserveOriginal a folded token is also emitted unchanged, at the same position.
+*/
+
+// ASCIIFoldingFilter ascii folding filter
+type ASCIIFoldingFilter struct {
+	TokenFilter
+	preserveOriginal bool
+	pending          *Token // folded token waiting behind its original
+}
+
+var (
+	normalizerOnce sync.Once
+	nfkcMap        map[rune]string  // rune to its nfkc form
+	composeMap     map[[2]rune]rune // canonical compositions
+	asciiMap       map[rune]string  // rune to its ascii form
+)
+
+// caseFoldings foldings that differ from unicode.ToLower
+var caseFoldings = map[rune]string{
+	'ß': "ss",
+	'ẞ': "ss",
+	'ς': "σ",
+	'ϐ': "β",
+	'ϑ': "θ",
+	'ϕ': "φ",
+	'ϖ': "π",
+	'ϰ': "κ",
+	'ϱ': "ρ",
+	'ϵ': "ε",
+}
+
+// NewICUNormalizerFilter new normalizer filter
+func NewICUNormalizerFilter(input TokenStream) *ICUNormalizerFilter {
+	return &ICUNormalizerFilter{TokenFilter{input: input}}
+}
+
+// NewASCIIFoldingFilter new ascii folding filter
+func NewASCIIFoldingFilter(input TokenStream, preserveOriginal bool) *ASCIIFoldingFilter {
+	return &ASCIIFoldingFilter{
+		TokenFilter:      TokenFilter{input: input},
+		preserveOriginal: preserveOriginal,
+	}
+}
+
+// loadNormalizer parse the embedded normalizer tables
+func loadNormalizer() {
+	nfkcMap = map[rune]string{}
+	for _, entry := range strings.Split(nfkcData, "\n") {
+		r, size := utf8.DecodeRuneInString(entry)
+		if size > 0 {
+			nfkcMap[r] = entry[size:]
+		}
+	}
+
+	composeMap = map[[2]rune]rune{}
+	for _, entry := range strings.Split(composeData, "\n") {
+		runes := []rune(entry)
+		if len(runes) == 3 {
+			composeMap[[2]rune{runes[0], runes[1]}] = runes[2]
+		}
+	}
+
+	asciiMap = map[rune]string{}
+	for _, entry := range strings.Split(asciiFoldingData, "\n") {
+		r, size := utf8.DecodeRuneInString(entry)
+		if size > 0 {
+			asciiMap[r] = entry[size:]
+		}
+	}
+}
+
+// normalizeNFKCCaseFold nfkc normalize and case fold text
+func normalizeNFKCCaseFold(text string) string {
+	normalizerOnce.Do(loadNormalizer)
+
+	// (1) compatibility mapping and case folding
+	var b strings.Builder
+	for _, r := range text {
+		if mapped, found := nfkcMap[r]; found {
+			for _, m := range mapped {
+				foldCase(&b, m)
+			}
+			continue
+		}
+		foldCase(&b, r)
+	}
+
+	// (2) canonical composition
+	composed := []rune{}
+	for _, r := range b.String() {
+		n := len(composed)
+		if n > 0 {
+			if c, found := composeMap[[2]rune{composed[n-1], r}]; found {
+				composed[n-1] = c
+				continue
+			}
+		}
+		composed = append(composed, r)
+	}
+	return string(composed)
+}
+
+// foldCase write the case folding of r
+func foldCase(b *strings.Builder, r rune) {
+	if folded, found := caseFoldings[r]; found {
+		b.WriteString(folded)
+		return
+	}
+	b.WriteRune(unicode.ToLower(r))
+}
+
+// FoldToASCII replace runes by their ascii form
+func FoldToASCII(text string) string {
+	normalizerOnce.Do(loadNormalizer)
+
+	var b strings.Builder
+	for _, r := range text {
+		if r < utf8.RuneSelf {
+			b.WriteRune(r)
+			continue
+		}
+		if folded, found := asciiMap[r]; found {
+			b.WriteString(folded)
+			continue
+		}
+		if r >= 0x0300 && r <= 0x036F { // combining accents of decomposed text
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// ================================ICUNormalizerFilter=======================================
+
+// Next next token
+func (nf *ICUNormalizerFilter) Next() (*Token, error) {
+	t, err := nf.input.Next()
+	if t == nil || err != nil {
+		return t, err
+	}
+	t.TermText = normalizeNFKCCaseFold(t.TermText)
+	return t, nil
+}
+
+// ================================ASCIIFoldingFilter=======================================
+
+// Next next token
+func (af *ASCIIFoldingFilter) Next() (*Token, error) {
+	if af.pending != nil {
+		t := af.pending
+		af.pending = nil
+		return t, nil
+	}
+
+	t, err := af.input.Next()
+	if t == nil || err != nil {
+		return t, err
+	}
+	folded := FoldToASCII(t.TermText)
+	if folded == t.TermText {
+		return t, nil
+	}
+	if !af.preserveOriginal {
+		t.TermText = folded
+		return t, nil
+	}
+
+	f := *t
+	f.TermText = folded
+	f.PositionIncrement = 0
+	af.pending = &f
+	return t, nil
+}
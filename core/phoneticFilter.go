@@ -0,0 +1,305 @@
+package core
+
+import (
+	"strings"
+)
+
+/*
+A PhoneticFilter indexes how a token sounds rather than how it is spelled,
+so that romanized names spelled in different ways, e.g. "Tu Fu" and "Too Foo",
+or "Wang Wei" and "Vang Vey", meet on the same terms.
+
+Each token is encoded by a PhoneticEncoder.
+With inject the codes are stacked at the position of the original token, which is kept,
+otherwise the codes replace it.
+Tokens without a code, e.g. digits or han characters, pass through unchanged.
+The same filter is used at query time so that the query is encoded the same way.
+*/
+
+// PhoneticFilter phonetic filter
+type PhoneticFilter struct {
+	TokenFilter
+	encoder PhoneticEncoder
+	inject  bool     // keep the original token
+	emitted []*Token // codes waiting behind their token
+}
+
+// PhoneticEncoder phonetic codes of a word
+type PhoneticEncoder interface {
+	Encode(word string) []string
+}
+
+// PhoneticTokenType type of a phonetic code token
+const PhoneticTokenType = "phonetic"
+
+/*
+Soundex is the american soundex code,
+the first letter followed by three digits for the following consonant sounds,
+e.g. "Robert" and "Rupert" are both R163.
+*/
+
+// Soundex soundex encoder
+type Soundex struct {
+}
+
+/*
+Metaphone is Lawrence Philips' original metaphone code,
+built from english pronunciation rules,
+e.g. "Knight" is NT and "Thomas" is 0MS, 0 standing for th.
+MaxLength limits the length of a code, four when zero.
+*/
+
+// Metaphone metaphone encoder
+type Metaphone struct {
+	MaxLength int
+}
+
+// soundexCodes digit of every letter, '0' for vowels which separate equal digits
+const soundexCodes = "01230120022455012623010202"
+
+// NewPhoneticFilter new phonetic filter
+func NewPhoneticFilter(input TokenStream, encoder PhoneticEncoder, inject bool) *PhoneticFilter {
+	return &PhoneticFilter{
+		TokenFilter: TokenFilter{input: input},
+		encoder:     encoder,
+		inject:      inject,
+	}
+}
+
+// PhoneticFilterFactory phonetic filter for an analyzer chain
+func PhoneticFilterFactory(encoder PhoneticEncoder, inject bool) TokenFilterFactory {
+	return func(input TokenStream) TokenStream {
+		return NewPhoneticFilter(input, encoder, inject)
+	}
+}
+
+// asciiLetters upper case a-z letters of word, others dropped
+func asciiLetters(word string) []byte {
+	letters := []byte{}
+	for _, r := range strings.ToUpper(word) {
+		if r >= 'A' && r <= 'Z' {
+			letters = append(letters, byte(r))
+		}
+	}
+	return letters
+}
+
+// ================================PhoneticFilter=======================================
+
+// Next next token or code
+func (pf *PhoneticFilter) Next() (*Token, error) {
+	if len(pf.emitted) > 0 {
+		t := pf.emitted[0]
+		pf.emitted = pf.emitted[1:]
+		return t, nil
+	}
+
+	t, err := pf.input.Next()
+	if t == nil || err != nil {
+		return t, err
+	}
+	codes := pf.encoder.Encode(t.TermText)
+	if len(codes) == 0 {
+		return t, nil
+	}
+
+	for _, code := range codes {
+		if pf.inject && code == t.TermText {
+			continue
+		}
+		c := newToken(code, t.StartOffset, t.EndOffset, PhoneticTokenType)
+		c.PositionIncrement = 0
+		c.PositionLength = t.PositionLength
+		pf.emitted = append(pf.emitted, c)
+	}
+	if pf.inject {
+		return t, nil
+	}
+
+	first := pf.emitted[0]
+	pf.emitted = pf.emitted[1:]
+	first.PositionIncrement = t.PositionIncrement
+	return first, nil
+}
+
+// ================================Soundex=======================================
+
+// Encode soundex code, none for a word without letters
+func (sx Soundex) Encode(word string) []string {
+	letters := asciiLetters(word)
+	if len(letters) == 0 {
+		return nil
+	}
+
+	code := []byte{letters[0]}
+	last := soundexCodes[letters[0]-'A']
+	for _, c := range letters[1:] {
+		if c == 'H' || c == 'W' { // do not separate equal codes
+			continue
+		}
+		digit := soundexCodes[c-'A']
+		if digit != '0' && digit != last {
+			code = append(code, digit)
+			if len(code) == 4 {
+				break
+			}
+		}
+		last = digit
+	}
+	for len(code) < 4 {
+		code = append(code, '0')
+	}
+	return []string{string(code)}
+}
+
+// ================================Metaphone=======================================
+
+// Encode metaphone code, none for a word without letters
+func (mp Metaphone) Encode(word string) []string {
+	maxLength := mp.MaxLength
+	if maxLength <= 0 {
+		maxLength = 4
+	}
+	w := asciiLetters(word)
+	if len(w) == 0 {
+		return nil
+	}
+	if len(w) == 1 {
+		return []string{string(w)}
+	}
+
+	// initial letter exceptions
+	switch {
+	case (w[0] == 'K' || w[0] == 'G' || w[0] == 'P') && w[1] == 'N',
+		w[0] == 'A' && w[1] == 'E',
+		w[0] == 'W' && w[1] == 'R':
+		w = w[1:]
+	case w[0] == 'W' && w[1] == 'H':
+		w = w[1:]
+		w[0] = 'W'
+	case w[0] == 'X':
+		w[0] = 'S'
+	}
+
+	at := func(n int) byte {
+		if n < 0 || n >= len(w) {
+			return 0
+		}
+		return w[n]
+	}
+	isVowel := func(n int) bool {
+		return at(n) != 0 && strings.IndexByte("AEIOU", at(n)) >= 0
+	}
+	isFrontVowel := func(n int) bool {
+		return at(n) != 0 && strings.IndexByte("EIY", at(n)) >= 0
+	}
+	region := func(n int, s string) bool {
+		return n >= 0 && n+len(s) <= len(w) && string(w[n:n+len(s)]) == s
+	}
+	isLast := func(n int) bool {
+		return n+1 == len(w)
+	}
+
+	code := []byte{}
+	for n := 0; n < len(w) && len(code) < maxLength; n++ {
+		c := w[n]
+		if c != 'C' && at(n-1) == c { // double letters sound once
+			continue
+		}
+		switch c {
+		case 'A', 'E', 'I', 'O', 'U':
+			if n == 0 {
+				code = append(code, c)
+			}
+		case 'B':
+			if !(at(n-1) == 'M' && isLast(n)) { // silent in -mb
+				code = append(code, 'B')
+			}
+		case 'C':
+			switch {
+			case at(n-1) == 'S' && isFrontVowel(n+1): // sci, sce, scy
+			case region(n, "CIA"):
+				code = append(code, 'X')
+			case isFrontVowel(n + 1):
+				code = append(code, 'S')
+			case at(n-1) == 'S' && at(n+1) == 'H':
+				code = append(code, 'K')
+			case at(n+1) == 'H':
+				if n == 0 && len(w) >= 3 && isVowel(2) {
+					code = append(code, 'K')
+				} else {
+					code = append(code, 'X')
+				}
+			default:
+				code = append(code, 'K')
+			}
+		case 'D':
+			if at(n+1) == 'G' && isFrontVowel(n+2) {
+				code = append(code, 'J')
+				n = n + 2
+			} else {
+				code = append(code, 'T')
+			}
+		case 'G':
+			switch {
+			case at(n+1) == 'H' && (isLast(n+1) || !isVowel(n+2)): // silent gh
+			case n > 0 && region(n, "GN"): // silent in -gn, -gned
+			case isFrontVowel(n+1) && at(n-1) != 'G':
+				code = append(code, 'J')
+			default:
+				code = append(code, 'K')
+			}
+		case 'H':
+			if !isLast(n) && !(n > 0 && strings.IndexByte("CSPTG", at(n-1)) >= 0) && isVowel(n+1) {
+				code = append(code, 'H')
+			}
+		case 'K':
+			if at(n-1) != 'C' {
+				code = append(code, 'K')
+			}
+		case 'P':
+			if at(n+1) == 'H' {
+				code = append(code, 'F')
+			} else {
+				code = append(code, 'P')
+			}
+		case 'Q':
+			code = append(code, 'K')
+		case 'S':
+			if region(n, "SH") || region(n, "SIO") || region(n, "SIA") {
+				code = append(code, 'X')
+			} else {
+				code = append(code, 'S')
+			}
+		case 'T':
+			switch {
+			case region(n, "TIA") || region(n, "TIO"):
+				code = append(code, 'X')
+			case region(n, "TCH"):
+			case region(n, "TH"):
+				code = append(code, '0')
+			default:
+				code = append(code, 'T')
+			}
+		case 'V':
+			code = append(code, 'F')
+		case 'W', 'Y':
+			if !isLast(n) && isVowel(n+1) {
+				code = append(code, c)
+			}
+		case 'X':
+			code = append(code, 'K', 'S')
+		case 'Z':
+			code = append(code, 'S')
+		default: // F, J, L, M, N, R
+			code = append(code, c)
+		}
+	}
+	if len(code) > maxLength {
+		code = code[:maxLength]
+	}
+	if len(code) == 0 {
+		return nil
+	}
+	return []string{string(code)}
+}
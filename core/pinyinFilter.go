@@ -0,0 +1,359 @@
+package core
+
+//go:generate go run maketables.go
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+)
+
+/*
+A PinyinFilter transliterates the han characters of each token into pinyin,
+so that author and title fields can be searched from an ASCII keyboard.
+
+PinyinFull emits the joined syllables of a token ("libai" for 李白),
+PinyinInitials the first letter of each syllable ("lb"),
+PinyinBoth emits both.
+With tones the syllables keep their tone marks ("lǐbái"),
+without them ü is written as v, as pinyin input methods do.
+Runes other than han characters are copied unchanged.
+
+Readings come from an embedded table with one reading per character.
+Polyphonic characters are read from a word table first,
+so 长安 is read cháng ān and 长大 zhǎng dà.
+
+The pinyin is stacked on the position of the original token,
+which is kept when keepOriginal is set.
+Tokens without han characters pass through untouched.
+The filter works on whole tokens,
+so names should be tokenized with a KeywordTokenizer rather than one ideograph per token.
+*/
+
+// PinyinFilter pinyin transliteration filter
+type PinyinFilter struct {
+	TokenFilter
+	mode         PinyinMode
+	tones        bool
+	keepOriginal bool
+	emitted      []*Token // tokens ready to be returned
+}
+
+// PinyinMode which pinyin forms to emit
+type PinyinMode int
+
+const (
+	// PinyinFull joined full pinyin
+	PinyinFull PinyinMode = iota
+	// PinyinInitials first letter of every syllable
+	PinyinInitials
+	// PinyinBoth full pinyin and initials
+	PinyinBoth
+)
+
+var (
+	pinyinOnce    sync.Once
+	pinyinReading map[rune]string // default reading of each rune
+	pinyinMaxWord int             // longest word of pinyinWords, in runes
+)
+
+// pinyinDefaults default readings better suited to classical poetry than the table's
+var pinyinDefaults = map[rune]string{
+	'长': "cháng",
+	'長': "cháng",
+	'为': "wéi",
+	'為': "wéi",
+	'还': "huán",
+	'還': "huán",
+	'兴': "xīng",
+	'興': "xīng",
+	'都': "dū",
+	'率': "shuài",
+}
+
+// pinyinWords readings of polyphonic characters inside common words
+var pinyinWords = map[string]string{
+	"长安":  "cháng ān",
+	"长江":  "cháng jiāng",
+	"长城":  "cháng chéng",
+	"长沙":  "cháng shā",
+	"长亭":  "cháng tíng",
+	"长河":  "cháng hé",
+	"长风":  "cháng fēng",
+	"长干":  "cháng gān",
+	"长恨歌": "cháng hèn gē",
+	"长大":  "zhǎng dà",
+	"长者":  "zhǎng zhě",
+	"生长":  "shēng zhǎng",
+	"长老":  "zhǎng lǎo",
+	"行人":  "xíng rén",
+	"行路":  "xíng lù",
+	"银行":  "yín háng",
+	"行列":  "háng liè",
+	"一行":  "yī háng",
+	"两行":  "liǎng háng",
+	"成行":  "chéng háng",
+	"音乐":  "yīn yuè",
+	"乐府":  "yuè fǔ",
+	"乐游原": "lè yóu yuán",
+	"重阳":  "chóng yáng",
+	"重九":  "chóng jiǔ",
+	"重来":  "chóng lái",
+	"万重山": "wàn chóng shān",
+	"千重":  "qiān chóng",
+	"重重":  "chóng chóng",
+	"还家":  "huán jiā",
+	"还乡":  "huán xiāng",
+	"还有":  "hái yǒu",
+	"朝辞":  "zhāo cí",
+	"朝夕":  "zhāo xī",
+	"朝阳":  "zhāo yáng",
+	"今朝":  "jīn zhāo",
+	"朝廷":  "cháo tíng",
+	"曾经":  "céng jīng",
+	"曾参":  "zēng shēn",
+	"成都":  "chéng dū",
+	"都是":  "dōu shì",
+	"将军":  "jiāng jūn",
+	"将进酒": "qiāng jìn jiǔ",
+	"单于":  "chán yú",
+	"可汗":  "kè hán",
+	"吐蕃":  "tǔ bō",
+	"龟兹":  "qiū cí",
+	"阿房宫": "ē páng gōng",
+	"会稽":  "kuài jī",
+	"少年":  "shào nián",
+	"参差":  "cēn cī",
+	"尉迟":  "yù chí",
+	"万俟":  "mò qí",
+	"燕山":  "yān shān",
+	"燕赵":  "yān zhào",
+	"幽燕":  "yōu yān",
+	"落叶":  "luò yè",
+	"叶公":  "shè gōng",
+	"大夫":  "dài fū",
+	"宿雨":  "sù yǔ",
+	"星宿":  "xīng xiù",
+	"骑马":  "qí mǎ",
+	"铁骑":  "tiě jì",
+	"千骑":  "qiān jì",
+	"看取":  "kàn qǔ",
+	"思量":  "sī liang",
+	"相思":  "xiāng sī",
+	"丞相":  "chéng xiàng",
+	"宰相":  "zǎi xiàng",
+	"几时":  "jǐ shí",
+	"几乎":  "jī hū",
+	"更深":  "gēng shēn",
+	"五更":  "wǔ gēng",
+	"三更":  "sān gēng",
+	"教化":  "jiào huà",
+	"不教":  "bù jiào",
+	"应是":  "yīng shì",
+	"回应":  "huí yìng",
+	"斜阳":  "xié yáng",
+	"处士":  "chǔ shì",
+	"何处":  "hé chù",
+	"好奇":  "hào qí",
+	"好学":  "hào xué",
+	"中酒":  "zhòng jiǔ",
+	"间关":  "jiān guān",
+	"间谍":  "jiàn dié",
+	"数声":  "shù shēng",
+	"数落":  "shǔ luo",
+	"难民":  "nàn mín",
+	"患难":  "huàn nàn",
+	"调笑":  "tiáo xiào",
+	"调和":  "tiáo hé",
+	"传记":  "zhuàn jì",
+	"列传":  "liè zhuàn",
+	"降龙":  "xiáng lóng",
+	"投降":  "tóu xiáng",
+	"度曲":  "dù qǔ",
+	"揣度":  "chuǎi duó",
+	"种树":  "zhòng shù",
+	"种田":  "zhòng tián",
+	"便宜":  "pián yi",
+	"省亲":  "xǐng qīn",
+	"反省":  "fǎn xǐng",
+	"识字":  "shí zì",
+	"标识":  "biāo zhì",
+	"为人":  "wéi rén",
+	"为谁":  "wèi shuí",
+	"因为":  "yīn wèi",
+}
+
+// NewPinyinFilter new pinyin filter
+func NewPinyinFilter(input TokenStream, mode PinyinMode, tones bool, keepOriginal bool) *PinyinFilter {
+	return &PinyinFilter{
+		TokenFilter:  TokenFilter{input: input},
+		mode:         mode,
+		tones:        tones,
+		keepOriginal: keepOriginal,
+	}
+}
+
+// loadPinyin parse the embedded reading table
+func loadPinyin() {
+	pinyinReading = map[rune]string{}
+	for _, line := range strings.Split(pinyinData, "\n") {
+		space := strings.IndexByte(line, ' ')
+		if space < 0 {
+			continue
+		}
+		reading := line[:space]
+		for _, r := range line[space+1:] {
+			pinyinReading[r] = reading
+		}
+	}
+	for r, reading := range pinyinDefaults {
+		pinyinReading[r] = reading
+	}
+	for word := range pinyinWords {
+		if n := utf8.RuneCountInString(word); n > pinyinMaxWord {
+			pinyinMaxWord = n
+		}
+	}
+}
+
+// pinyinSyllables syllables of text, runes without a reading are returned as they are
+func pinyinSyllables(text string) ([]string, bool) {
+	pinyinOnce.Do(loadPinyin)
+
+	var (
+		syllables []string
+		other     strings.Builder
+	)
+	hasHan := false
+	runes := []rune(text)
+	flush := func() {
+		if other.Len() > 0 {
+			syllables = append(syllables, other.String())
+			other.Reset()
+		}
+	}
+
+	i := 0
+	for i < len(runes) {
+		matched := false
+		for n := pinyinMaxWord; n > 1; n-- { // longest word first
+			if i+n > len(runes) {
+				continue
+			}
+			if reading, found := pinyinWords[string(runes[i:i+n])]; found {
+				flush()
+				syllables = append(syllables, strings.Fields(reading)...)
+				hasHan = true
+				i = i + n
+				matched = true
+				break
+			}
+		}
+		if matched {
+			continue
+		}
+
+		if reading, found := pinyinReading[runes[i]]; found {
+			flush()
+			syllables = append(syllables, reading)
+			hasHan = true
+		} else {
+			other.WriteRune(runes[i])
+		}
+		i = i + 1
+	}
+	flush()
+	return syllables, hasHan
+}
+
+// stripTones remove tone marks, ü becomes v
+func stripTones(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case 'ā', 'á', 'ǎ', 'à':
+			r = 'a'
+		case 'ē', 'é', 'ě', 'è', 'ê':
+			r = 'e'
+		case 'ī', 'í', 'ǐ', 'ì':
+			r = 'i'
+		case 'ō', 'ó', 'ǒ', 'ò':
+			r = 'o'
+		case 'ū', 'ú', 'ǔ', 'ù':
+			r = 'u'
+		case 'ǖ', 'ǘ', 'ǚ', 'ǜ', 'ü':
+			r = 'v'
+		case 'ń', 'ň', 'ǹ':
+			r = 'n'
+		case 'ḿ':
+			r = 'm'
+		}
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// ================================PinyinFilter=======================================
+
+// Next next token
+func (pf *PinyinFilter) Next() (*Token, error) {
+	for len(pf.emitted) == 0 {
+		t, err := pf.input.Next()
+		if t == nil || err != nil {
+			return t, err
+		}
+		pf.transliterate(t)
+	}
+
+	t := pf.emitted[0]
+	pf.emitted = pf.emitted[1:]
+	return t, nil
+}
+
+// transliterate queue the original and pinyin forms of a token
+func (pf *PinyinFilter) transliterate(t *Token) {
+	syllables, hasHan := pinyinSyllables(t.TermText)
+	if !hasHan {
+		pf.emitted = append(pf.emitted, t)
+		return
+	}
+
+	var full, initials strings.Builder
+	for _, s := range syllables {
+		if !pf.tones {
+			s = stripTones(s)
+		}
+		full.WriteString(s)
+		first, _ := utf8.DecodeRuneInString(stripTones(s))
+		initials.WriteRune(first)
+	}
+
+	texts := []string{}
+	if pf.keepOriginal {
+		texts = append(texts, t.TermText)
+	}
+	if pf.mode == PinyinFull || pf.mode == PinyinBoth {
+		texts = append(texts, full.String())
+	}
+	if pf.mode == PinyinInitials || pf.mode == PinyinBoth {
+		texts = append(texts, initials.String())
+	}
+
+	for i, text := range texts {
+		if i == 0 && pf.keepOriginal {
+			pf.emitted = append(pf.emitted, t)
+			continue
+		}
+		p := newToken(text, t.StartOffset, t.EndOffset, "<PINYIN>")
+		if i == 0 {
+			p.PositionIncrement = t.PositionIncrement
+		} else {
+			p.PositionIncrement = 0
+		}
+		pf.emitted = append(pf.emitted, p)
+	}
+}
@@ -0,0 +1,1388 @@
+// Code generated by go run maketables.go; DO NOT EDIT.
+// Source: ICU Han-Latin transform.
+
+package core
+
+// pinyinData one reading per line followed by the runes read that way
+const pinyinData = "" +
+	"a 啊\n" +
+	"ba 吧紦\n" +
+	"ban 螁\n" +
+	"bei 呗唄\n" +
+	"beng 揼\n" +
+	"bian 炞\n" +
+	"bin 氞\n" +
+	"biàn 便卞变変峅弁徧忭抃昪汳汴玣緶缏艑苄覍變辡辧辨辩辫辮辯遍釆閞\n" +
+	"biào 俵鰾鳔\n" +
+	"biè 彆\n" +
+	"bié 別别咇徶莂蛂襒蹩\n" +
+	"biān 揙煸牑猵獱甂砭笾箯籩編编蝙边辺邉邊鍽鞭鯾鯿鳊\n" +
+	"biāo 儦墂幖彪摽杓标標淲滮瀌灬熛爂猋瘭磦穮脿膘臕蔈藨謤贆鏢鑣镖镳颩颮颷飆飇飈飊飑飙飚驃驫骉骠髟\n" +
+	"biē 憋虌蟞鱉鳖鼈龞\n" +
+	"biě 瘪癟\n" +
+	"biǎn 匾惼扁碥稨窆糄萹藊褊貶贬鴘\n" +
+	"biǎo 婊檦表裱褾諘錶\n" +
+	"bo 卜萡\n" +
+	"bà 坝垻壩弝欛灞爸矲罢罷耙覇跁霸鮊鲅鲌\n" +
+	"bài 庍拜拝敗猈稗粺薭贁败韛\n" +
+	"bàn 伴办半坢姅怑扮拌柈湴瓣秚絆绊辦鉡靽\n" +
+	"bàng 傍塝搒棒棓玤磅稖艕蒡蚌蜯謗谤鎊镑\n" +
+	"bào 儤勽報忁报抱暴曓爆菢虣蚫袌豹趵鉋鑤铇靤骲髱鮑鲍\n" +
+	"bá 叐坺墢妭抜拔炦犮癹胈茇菝詙跋軷颰魃鼥\n" +
+	"bái 白\n" +
+	"báo 嫑窇薄雹\n" +
+	"bèi 俻倍偝偹備僃备孛悖惫愂憊昁梖焙牬犕狈狽珼琲碚禙糒背苝蓓蛽被褙誖貝贝軰輩辈邶郥鄁鋇鐾钡鞁鞴骳\n" +
+	"bèn 倴坋坌捹撪桳渀獖笨輽逩\n" +
+	"bèng 塴泵甏蹦迸逬鏰镚\n" +
+	"béng 甭\n" +
+	"bì 佖哔嗶坒堛壁奰妼婢嬖币幣幤庇庳廦弊弻弼彃必怭怶愊愎敝斃枈柲梐毕毖毙湢滗滭潷濞煏熚狴獘獙珌璧畀畁畢疪痹痺皕睤碧禆笓筚箅箆篦篳粊綼縪繴罼腷臂苾荜萆萞蓖蓽蔽薜蜌袐裨襅襞襣觱詖诐貱賁贔赑跸蹕躃躄避邲鄨鄪鉍鏎鐴铋閇閉閟闭陛鞸韠飶饆馝駜驆髀髲魓鮅鷝鷩鼊\n" +
+	"bìn 摈擯殡殯膑臏髌髕髩鬂鬓鬢\n" +
+	"bìng 並併倂偋傡垪寎并幷庰栤病竝誁靐鮩\n" +
+	"bí 嬶荸鼻\n" +
+	"bò 孹檗糪蘗譒\n" +
+	"bó 亳仢伯侼僰勃博嚗帛愽懪挬搏欂浡淿渤煿牔犦犻狛猼瓝瓟礡礴秡箔簙肑胉脖膊舶艊苩葧蔔袯袹襏襮豰踣郣鈸鉑鋍鎛鑮钹铂镈餺馎馛馞駁駮驳髆髉鵓鹁\n" +
+	"bù 不佈勏吥咘埔埗埠布廍怖悑抪捗柨步歨歩瓿篰簿荹蔀踄部郶钚餔餢\n" +
+	"bú 轐醭鳪\n" +
+	"bā 丷仈八叭哵夿岜峇巴巼扒捌朳柭玐疤笆粑羓芭蚆豝釛釟魞鲃\n" +
+	"bāi 挀掰擘\n" +
+	"bān 扳搬攽斑斒班瘢癍般螌褩辬頒颁鳻\n" +
+	"bāng 垹帮幇幚幫捠梆浜縍邦邫鞤\n" +
+	"bāo 佨勹包孢枹煲笣胞苞蕔褒襃闁齙龅\n" +
+	"bēi 卑悲揹杯桮椑盃碑藣陂鵯鹎\n" +
+	"bēn 奔栟泍犇贲錛锛\n" +
+	"bēng 伻傰嘣奟崩嵭痭祊絣綳绷閍\n" +
+	"běi 北鉳\n" +
+	"běn 奙本楍畚翉苯\n" +
+	"běng 埄埲琣琫繃菶鞛\n" +
+	"bī 偪屄楅榌毴螕豍逼鎞鰏鲾鵖\n" +
+	"bīn 傧儐宾彬斌梹椕槟檳汃滨濒濱濵瀕玢瑸璸砏繽缤虨豩豳賓賔邠鑌镔霦顮\n" +
+	"bīng 仌仒兵冫冰掤氷鋲\n" +
+	"bō 僠剝剥哱啵嶓帗拨撥播波溊玻癶癷盋砵碆紴缽菠袚袰蹳鉢钵餑饽驋鮁鱍\n" +
+	"bū 峬庯晡誧逋鈽钸\n" +
+	"bǎ 把鈀钯靶\n" +
+	"bǎi 佰捭摆擺柏栢瓸百竡粨絔襬\n" +
+	"bǎn 坂岅昄板版瓪粄舨蝂鈑钣闆阪魬\n" +
+	"bǎng 榜牓綁绑膀髈\n" +
+	"bǎo 保堡堢媬宝宲寚寳寶怉珤緥葆藵褓賲靌飹飽饱駂鳵鴇鸨\n" +
+	"bǐ 佊俾匕吡啚夶妣彼朼柀比沘疕秕笔筆箄粃聛舭貏鄙\n" +
+	"bǐng 丙怲抦摒昞昺柄棅炳眪禀秉稟窉苪蛃邴鈵鉼陃鞆鞞餅餠饼\n" +
+	"bǒ 箥簸跛\n" +
+	"bǔ 卟哺喸捕补補鵏鸔\n" +
+	"cao 艹\n" +
+	"chang 蟐\n" +
+	"chi 麶\n" +
+	"chu 榋橻\n" +
+	"chuài 啜嘬膪踹\n" +
+	"chuàn 串汌玔賗釧钏鶨\n" +
+	"chuàng 凔创刱剏剙創怆愴\n" +
+	"chuái 膗\n" +
+	"chuán 传傳圌暷椽篅舡舩船輲遄\n" +
+	"chuáng 噇幢床牀\n" +
+	"chuí 倕垂埀捶搥棰椎槌箠腄菙錘鎚锤陲顀\n" +
+	"chuò 嚽娕娖婼惙擉歠涰磭綽繛绰腏趠輟辍辵辶酫鑡齪龊\n" +
+	"chuā 欻歘\n" +
+	"chuāi 揣搋\n" +
+	"chuān 剶巛川氚猭瑏穿\n" +
+	"chuāng 刅摐牎牕疮瘡窓窗窻\n" +
+	"chuī 吹炊龡\n" +
+	"chuō 戳踔逴\n" +
+	"chuǎn 僢喘歂舛荈踳\n" +
+	"chuǎng 傸摤磢闖闯\n" +
+	"chà 侘奼姹岔差汊紁詫诧\n" +
+	"chài 囆瘥虿蠆袃訍\n" +
+	"chàn 忏懴懺摲硟羼韂顫颤\n" +
+	"chàng 倡唱怅悵暢焻玚瑒畅畼誯韔鬯\n" +
+	"chào 仦仯耖觘\n" +
+	"chá 垞察嵖搽查槎檫猹碴秅茬茶詧靫\n" +
+	"chái 侪儕喍柴犲祡豺齜\n" +
+	"chán 僝儃儳劖嚵壥婵嬋巉廛棎欃毚湹潹潺澶瀍瀺煘獑磛禅禪緾纏纒缠艬蝉蟬蟾誗讒谗躔鄽酁鋋鑱镡镵饞馋\n" +
+	"cháng 仧仩偿償兏嘗嚐塲嫦尝常徜瑺瓺甞肠腸膓苌萇鋿鏛镸鱨鲿\n" +
+	"cháo 嘲巢巣晁朝樔漅潮牊窲罺謿轈鄛鼂鼌\n" +
+	"chè 勶坼屮彻徹掣撤澈烢爡瞮硩聅迠頙\n" +
+	"chèn 儭嚫榇櫬疢衬襯讖谶趁趂齓齔龀\n" +
+	"chèng 秤\n" +
+	"chén 塵宸尘忱愖揨敐晨曟樄沉煁瘎臣茞莀莐蔯薼螴訦諶谌軙辰迧鈂陈陳霃鷐麎\n" +
+	"chéng 丞乗乘呈城埕堘塍塖娍宬峸惩憕懲成承挰掁晟朾枨棖椉橙檙洆溗澂澄瀓珵珹畻碀程窚筬絾脀脭荿裎誠诚郕酲鋮铖騬鯎\n" +
+	"chì 傺勅勑叱啻彳恜慗憏懘抶敕斥杘湁灻炽烾熾痓痸瘈瘛硳翄翅翤翨腟赤趩跮遫鉓銐雴飭饎饬鶒鷘\n" +
+	"chí 坻墀岻弛持歭池漦竾筂箎篪茌荎蚳謘貾赿趍踟迟遅遟遲馳驰\n" +
+	"chòng 揰銃铳\n" +
+	"chòu 殠臭臰遚\n" +
+	"chóng 崇崈爞緟虫蝩蟲褈隀\n" +
+	"chóu 仇俦儔嚋嬦帱幬怞惆愁懤栦椆燽畴疇皗稠筹籌紬絒綢绸菗薵裯讎讐踌躊酧酬醻雔雠\n" +
+	"chù 亍俶傗儊嘼埱处怵憷拀搐敊斶柷欪歜滀珿琡畜矗竌竐絀绌臅蓫處触觸諔豖踀鄐閦黜\n" +
+	"chú 刍厨媰幮廚橱櫉櫥滁犓篨耡芻蒢蒭蕏藸蜍蟵豠趎蹰躇躕鉏鋤锄除雏雛鶵\n" +
+	"chún 唇浱淳湻滣漘犉純纯脣莼蒓蓴醇醕錞陙鯙鶉鹑\n" +
+	"chā 偛叉嗏扠挿插揷杈疀肞臿艖銟鍤锸餷馇\n" +
+	"chāi 拆芆釵钗\n" +
+	"chān 幨搀攙梴裧襜覘觇辿鉆鋓\n" +
+	"chāng 伥倀娼昌晿椙淐猖琩菖裮錩锠閶阊鯧鲳鼚\n" +
+	"chāo 勦弨怊抄欩焯訬超鈔钞\n" +
+	"chē 伡俥唓砗硨莗蛼車车\n" +
+	"chēn 嗔抻捵琛瞋綝縝諃謓賝郴\n" +
+	"chēng 偁僜憆摚撐撑柽棦橕檉泟浾湞爯牚琤瞠称稱穪竀緽罉蛏蟶赪赬鏳鏿鐣阷靗頳饓\n" +
+	"chě 偖扯撦\n" +
+	"chěn 墋夦硶碜磣贂趻踸醦鍖\n" +
+	"chěng 侱庱徎悜睈逞騁骋\n" +
+	"chī 侙吃哧喫嗤噄妛媸彨彲摛攡瓻痴癡眵瞝笞粚絺胵蚩螭訵誺魑鴟鵄鸱黐齝\n" +
+	"chōng 充冲嘃徸忡憃憧摏沖浺珫罿翀舂艟茺衝蹖\n" +
+	"chōu 婤抽搊犨犫瘳篘\n" +
+	"chū 出初岀摴樗貙齣\n" +
+	"chūn 堾媋旾春暙杶椿槆橁櫄瑃箺萅蝽輴鰆鶞\n" +
+	"chǎ 衩蹅鑔镲\n" +
+	"chǎi 茝\n" +
+	"chǎn 丳产冁刬剗剷啴嘽囅嵼幝摌斺旵浐滻灛燀產産簅繟蒇蕆諂譂讇谄辴鏟铲閳闡阐骣\n" +
+	"chǎng 僘厂厰场場廠惝敞昶氅鋹\n" +
+	"chǎo 吵巐炒焣煼眧麨\n" +
+	"chǐ 侈卶叺呎垑尺恥欼歯耻肔胣蚇袲袳裭褫鉹齒齿\n" +
+	"chǒng 埫宠寵\n" +
+	"chǒu 丑丒侴偢吜杻杽瞅矁醜魗\n" +
+	"chǔ 储儲処杵椘楚楮檚濋璴础礎褚齭齼\n" +
+	"chǔn 偆惷睶萶蠢賰\n" +
+	"cui 乼\n" +
+	"cuàn 殩熶爨窜竄篡簒\n" +
+	"cuán 巑櫕欑穳\n" +
+	"cuì 伜倅啐啛忰悴毳淬濢焠疩瘁竁粋粹紣綷翆翠脃脆脺膬膵臎萃襊顇\n" +
+	"cuò 剉剒厝夎挫措斮棤莝莡蓌逪銼錯锉错\n" +
+	"cuó 嵯嵳痤睉矬蒫蔖虘躦酂鹺鹾\n" +
+	"cuān 撺攛汆蹿躥鋑鑹镩\n" +
+	"cuī 催凗墔崔嶉慛摧榱槯獕磪縗缞鏙\n" +
+	"cuō 搓撮瑳磋蹉遳醝\n" +
+	"cuǐ 漼璀皠趡\n" +
+	"cuǒ 脞\n" +
+	"cà 囃遪\n" +
+	"cài 埰棌縩菜蔡\n" +
+	"càn 儏孱掺摻澯灿燦璨粲薒謲\n" +
+	"càng 賶\n" +
+	"cào 肏襙鄵\n" +
+	"cái 才材纔裁財财\n" +
+	"cán 惭慙慚残殘蚕蝅蠶蠺\n" +
+	"cáng 欌藏鑶\n" +
+	"cáo 嘈嶆曹曺槽漕艚蓸螬褿鏪\n" +
+	"cè 侧側冊册厕厠墄廁恻惻憡拺敇测測畟笧策筞筴箣簎粣荝萗萴蓛\n" +
+	"cèng 蹭\n" +
+	"cén 岑梣涔笒\n" +
+	"céng 层層嶒曾竲驓\n" +
+	"cì 伺佽刺刾庛朿栨次絘茦莿蛓螆賜赐\n" +
+	"cí 垐堲嬨慈柌濨珁瓷甆磁礠祠糍茈茨薋詞词辝辞辤辭雌飺餈鴜鶿鷀鹚\n" +
+	"còng 憁謥\n" +
+	"còu 凑湊腠輳辏\n" +
+	"cóng 丛从叢婃孮従徖從悰慒樷欉淙漎潀潨灇爜琮藂誴賨賩\n" +
+	"cù 促噈媨憱猝瘄瘯簇縬脨蔟誎趗踧蹙蹴蹵酢醋顣鼀\n" +
+	"cùn 吋寸籿\n" +
+	"cú 徂殂\n" +
+	"cún 侟存拵\n" +
+	"cā 嚓擦攃\n" +
+	"cāi 偲猜\n" +
+	"cān 傪参參叄叅喰嬠湌爘飡餐驂骖\n" +
+	"cāng 仓仺伧倉傖嵢沧滄濸獊舱艙苍蒼螥鶬鸧\n" +
+	"cāo 撡操糙\n" +
+	"cēn 嵾\n" +
+	"cēng 噌曽\n" +
+	"cī 偨呲疵縒蠀趀跐骴髊齹\n" +
+	"cōng 匆囪囱忩怱悤暰枞棇樅樬漗焧熜瑽璁瞛篵緫繱聡聦聪聰苁茐葱蓯蔥蟌鍯鏦騘驄骢\n" +
+	"cū 粗觕麁麄麤\n" +
+	"cūn 村澊皴竴膥踆邨\n" +
+	"cǎ 礤礸\n" +
+	"cǎi 倸啋婇寀彩採毝睬綵跴踩采\n" +
+	"cǎn 惨慘憯朁穇篸黪黲\n" +
+	"cǎo 愺懆艸草騲\n" +
+	"cǐ 佌此泚玼皉鮆\n" +
+	"cǔn 刌忖\n" +
+	"da 垯墶瘩繨\n" +
+	"dai 鮘\n" +
+	"de 地的脦\n" +
+	"diàn 佃坫垫墊壂奠婝店惦扂橂橝殿淀澱玷琔电癜簟蜔钿阽電靛驔\n" +
+	"diào 伄吊弔掉瘹窎窵竨蓧藋訋調调釣鈟銱鋽鑃钓铞铫雿魡\n" +
+	"diè 哋眰\n" +
+	"dié 叠喋垤堞峌嵽幉恎惵戜挕揲昳曡殜氎牃牒瓞畳疂疉疊眣碟絰绖耊耋胅臷艓苵蜨蝶褋詄諜谍趃蹀迭镻鰈鲽\n" +
+	"diān 傎厧嵮巅巓巔掂攧敁槇槙滇甸瘨癫癲蹎顚顛颠齻\n" +
+	"diāo 凋刁刟叼奝弴彫殦汈琱瞗碉簓虭蛁貂雕鮉鯛鲷鳭鵰鼦\n" +
+	"diē 嗲爹褺跌\n" +
+	"diū 丟丢銩铥\n" +
+	"diǎn 典嚸奌婰敟椣点猠碘蒧蕇跕踮點\n" +
+	"diǎo 屌扚\n" +
+	"duàn 塅断斷椴段毈煅瑖碫簖籪緞缎腶葮躖鍛锻\n" +
+	"duì 兊兌兑对対對怼憝憞懟濧瀩碓祋綐薱襨譈譵鐓镦队陮隊\n" +
+	"duò 刴剁堕墮墯尮嶞惰憜柁柮桗舵跢跥跺陊陏飿饳鵽\n" +
+	"duó 凙剫喥夺奪敓敚痥踱鈬鐸铎鮵\n" +
+	"duān 偳剬媏端耑褍鍴\n" +
+	"duī 垖堆塠嵟痽磓鐜鴭\n" +
+	"duō 剟咄哆嚉多夛崜掇敠敪毲畓裰\n" +
+	"duǎn 短\n" +
+	"duǐ 頧\n" +
+	"duǒ 亸哚嚲垛垜埵奲挅挆朵朶椯綞缍趓躱躲軃鍺\n" +
+	"dà 亣大汏眔\n" +
+	"dài 代侢叇垈埭岱帒带帯帶廗待怠戴曃柋殆瀻玳瑇甙簤紿緿绐艜蚮袋襶貸贷蹛軑軚軩轪迨霴靆骀鴏黛黱\n" +
+	"dàn 但僤啖啗啿嘾噉嚪帎弹弾彈惮憚憺旦柦氮沊泹淡澹狚疍癚禫窞繵腅萏蓞蛋蜑觛誕诞贉霮饏馾駳髧鴠\n" +
+	"dàng 儅凼圵垱壋婸宕嵣愓档檔氹潒璗瓽盪瞊砀碭礑簜荡菪蕩蘯趤逿闣雼\n" +
+	"dào 倒到噵悼椡檤焘燾瓙盗盜稲稻箌纛翢翿艔菿衜衟軇道\n" +
+	"dá 剳匒呾哒妲怛沓炟燵畗畣笪答羍荙薘蟽詚跶躂达迏迖迚逹達鎉鐽阘靼鞑韃龖龘\n" +
+	"dáo 捯\n" +
+	"dèn 扥扽\n" +
+	"dèng 凳墱嶝櫈瞪磴邓鄧鐙镫隥\n" +
+	"dé 得徳德恴悳惪棏淂鍀锝\n" +
+	"dì 俤偙僀啇坔埊墑墬娣媂嶳帝弟怟慸摕旳杕枤梊棣渧焍玓珶甋眱睇碲祶禘第締缔腣菂蒂蔕蝃螮諦谛踶递逓遞遰釱鉪\n" +
+	"dìng 啶定忊椗矴碇碠磸聢腚萣蝊訂订鋌錠铤锭顁飣饤\n" +
+	"dí 唙嘀嚁嫡廸敌敵梑樀涤滌狄笛篴籴糴翟苖荻蔋蔐藡覿觌豴蹢迪鏑靮頔馰髢鬄鸐\n" +
+	"dòng 侗働冻凍动動垌姛峒恫戙挏栋棟洞湩硐絧胨胴腖迵霘駧\n" +
+	"dòu 斗斣梪毭浢痘窦竇脰荳豆逗郖酘閗闘餖饾鬥鬦鬪鬬鬭\n" +
+	"dù 妒妬度杜殬渡秺肚芏荰螙蠧蠹鍍镀靯\n" +
+	"dùn 伅囤庉楯沌潡炖燉盾砘碷踲逇遁遯鈍钝頓顿\n" +
+	"dú 凟匵嬻椟櫝殰毒涜渎瀆牍牘犊犢独獨瓄皾碡蝳裻読讀讟读豄贕錖鑟韇韣韥騳髑黩黷\n" +
+	"dā 咑嗒噠搭撘笚耷荅褡鎝\n" +
+	"dāi 呆呔懛獃\n" +
+	"dān 丹儋勯匰单単單妉媅担擔殚殫甔瘅癉眈砃箪簞耼耽聃聸褝襌躭郸鄲頕鿕\n" +
+	"dāng 噹当澢珰璫當筜簹艡蟷裆襠鐺铛\n" +
+	"dāo 刀刂叨忉朷氘舠釖魛鱽\n" +
+	"dē 嘚\n" +
+	"dēng 噔嬁灯燈璒登竳簦艠覴豋蹬\n" +
+	"děng 戥朩等\n" +
+	"dī 仾低啲埞堤奃彽氐滴磾羝袛趆鍉镝隄鞮\n" +
+	"dīng 丁仃叮帄玎疔盯耵虰酊釘钉靪\n" +
+	"dōng 东倲冬咚埬娻岽崠崬徚昸東氡氭涷笗苳菄蝀鮗鯟鶇鶫鸫鼕鿴\n" +
+	"dōu 兜兠吺唗橷篼蔸都\n" +
+	"dū 剢厾嘟督醏闍阇\n" +
+	"dūn 吨噸墩墪惇撉撴敦橔犜獤礅蜳蹲蹾驐\n" +
+	"dǎ 打\n" +
+	"dǎi 傣歹逮\n" +
+	"dǎn 亶伔刐抌掸撢撣澸玬瓭疸紞胆膽衴赕黕黮\n" +
+	"dǎng 党挡擋攩欓灙譡讜谠黨\n" +
+	"dǎo 壔导導岛島嶋嶌嶹捣搗擣槝祷禂禱蹈陦隝隯\n" +
+	"dǐ 厎呧坘底弤抵拞掋柢牴砥聜菧觝詆诋軧邸阺骶鯳\n" +
+	"dǐng 奵嵿濎薡鐤頂顶鼎鼑\n" +
+	"dǒng 墥嬞懂箽董蕫諌\n" +
+	"dǒu 乧唞抖枓蚪鈄阧陡\n" +
+	"dǔ 堵帾琽睹笃篤覩賭赌\n" +
+	"dǔn 盹趸躉\n" +
+	"fang 堏\n" +
+	"fiào 覅\n" +
+	"fu 酜\n" +
+	"fà 珐琺蕟髪髮\n" +
+	"fàn 奿婏嬎梵汎泛滼犯畈盕笵範范訉販贩軓軬飯飰饭\n" +
+	"fàng 放趽\n" +
+	"fá 乏伐傠垡姂栰橃浌疺瞂砝笩筏罚罰罸茷藅閥阀\n" +
+	"fán 凡凢凣匥墦杋柉棥樊橎氾渢瀪瀿烦煩燔璠矾礬笲籵緐繁羳膰舤舧薠蘩蠜襎蹯鐇鐢钒鷭\n" +
+	"fáng 埅妨房肪防魴鰟鲂\n" +
+	"fèi 俷剕厞吠屝废廃廢昲曊杮櫠沸濷狒疿痱癈肺胇芾萉費费鐨镄陫靅鯡鼣\n" +
+	"fèn 份偾僨奋奮弅忿愤憤瀵秎粪糞膹鱝鲼\n" +
+	"fèng 俸凤奉湗焨煈甮縫缝賵赗鳯鳳鴌\n" +
+	"féi 淝肥腓蜰蟦\n" +
+	"fén 坟墳妢岎幩朌枌梤棼橨汾濆炃焚燌燓羒羵肦蒶蕡蚠蚡豮豶轒鐼隫馚馩魵黂鼖鼢\n" +
+	"féng 冯堸夆捀摓浲溄漨綘艂逢馮\n" +
+	"fó 仏坲梻\n" +
+	"fóu 紑裦\n" +
+	"fù 付偩傅冨副咐坿复妇婦媍嬔富峊復椱父祔禣秿竎緮縛缚腹萯蕧蚥蚹蛗蝜蝮袝複褔覄覆訃詂讣負賦賻负赋赙赴輹鍑鍢阜阝附陚馥駙驸鮒鰒鲋鳆\n" +
+	"fú 乀伏佛俘冹凫刜匐咈哹垘孚岪巿幅幞弗彿怫扶拂服枎柫栿桴棴榑氟泭洑浮涪澓炥烰玸琈甶畉畐癁砩祓福稪符笰箙粰紱紼絥綍绂绋罘罦翇艀艴芙芣苻茀茯莩菔葍虙蚨蜉蝠袱襆襥諨踾輻辐郛鉘鉜韍韨颫髴鮄鮲鳧鴔鵩鶝黻\n" +
+	"fā 发彂沷発發醱\n" +
+	"fān 勫噃嬏帆幡忛憣旙旛番籓繙翻蕃藩轓颿飜鱕\n" +
+	"fāng 匚坊方枋汸淓牥芳蚄邡鈁錺钫鴋\n" +
+	"fēi 啡妃婓婔扉暃渄猆緋绯菲蜚裶霏非靟飛飝飞餥馡騑騛鲱\n" +
+	"fēn 兝兺分吩哛帉昐朆棻氛竕紛纷翂芬衯訜躮酚鈖雰餴饙\n" +
+	"fēng 丰仹偑僼凨凬凮妦寷封峯峰崶枫桻楓檒沣沨灃烽犎猦琒疯瘋盽砜碸篈葑蘴蜂蠭豐鄷酆鋒鎽鏠锋闏霻靊風飌风麷\n" +
+	"fěi 匪奜悱斐朏棐榧篚翡胐蕜誹诽\n" +
+	"fěn 粉黺\n" +
+	"fěng 唪覂諷讽\n" +
+	"fū 伕呋垺夫妋姇娐孵尃怤懯敷旉柎玞痡砆稃筟糐紨綒肤膚荂荴衭豧趺跗邞鄜鈇鳺麩麬麱麸\n" +
+	"fǎ 佱法灋鍅\n" +
+	"fǎn 仮反払返釩\n" +
+	"fǎng 仿倣彷旊昉昘瓬眆紡纺舫訪访髣鶭\n" +
+	"fǒu 否妚殕缶缹缻雬鴀\n" +
+	"fǔ 乶俌俛俯呒嘸府弣抚拊捬撨撫斧椨滏焤甫盙簠胕腐腑蜅輔辅郙釜釡頫鬴鳬黼\n" +
+	"gong 慐\n" +
+	"guang 欟\n" +
+	"guà 卦啩坬挂掛絓罣罫褂詿诖\n" +
+	"guài 叏夬怪恠\n" +
+	"guàn 丱悹悺惯慣掼摜樌毌泴涫潅灌爟瓘盥矔礶祼罆罐貫贯躀遦鏆鑵雚鱹鸛鹳\n" +
+	"guàng 俇撗臦逛\n" +
+	"guì 刽刿劊劌匱嶡撌攰昋柜桂桧椢槶檜櫃炔猤癐瞶禬筀簂蓕襘貴贵跪鞼鱖鱥鳜\n" +
+	"guò 过過\n" +
+	"guó 囯囶囻国圀國帼幗慖漍聝腘膕蔮虢馘\n" +
+	"guā 刮劀栝歄煱瓜緺聒胍趏踻銽颪颳騧鴰鸹\n" +
+	"guāi 乖掴摑\n" +
+	"guān 倌关冠官棺瘝癏窤蒄覌観觀观関闗關鰥鱞鳏\n" +
+	"guāng 侊僙光咣垙姯桄洸灮炗炚炛烡珖胱茪輄銧黆\n" +
+	"guī 亀傀圭妫媯嫢嬀巂帰廆归摫椝槻槼櫷歸珪瑰璝瓌皈瞡硅窐胿膭茥螝袿規规邽郌閨闺騩鬶鬹鮭鲑龜龟\n" +
+	"guō 呙咼啯嘓埚堝墎崞彉彍濄瘑蝈蟈郭鈛鍋锅\n" +
+	"guǎ 冎剐剮叧寡\n" +
+	"guǎi 拐枴柺箉\n" +
+	"guǎn 琯痯筦管舘莞輨錧館馆鳤\n" +
+	"guǎng 广広廣犷獷臩\n" +
+	"guǐ 佹匦匭厬垝姽宄庋庪恑攱晷朹氿湀癸祪簋蛫蟡觤詭诡軌轨陒鬼\n" +
+	"guǒ 惈果椁槨淉猓粿綶菓蜾裹褁輠錁鐹餜馃\n" +
+	"gà 尬魀\n" +
+	"gài 丐乢匃匄戤摡杚概槩槪溉漑瓂盖葢蓋鈣钙阣隑\n" +
+	"gàn 倝凎干幹旰榦檊汵淦灨盰紺绀詌贑贛赣骭\n" +
+	"gàng 戅戆槓焵焹筻鿍\n" +
+	"gào 勂吿告峼祮祰禞筶誥诰郜鋯锆\n" +
+	"gá 噶尜錷钆\n" +
+	"gè 个個各硌箇虼铬\n" +
+	"gèn 亘亙揯搄茛\n" +
+	"gèng 堩暅更\n" +
+	"gé 佮匌呄嗝塥愅挌搿敋格槅櫊滆獦膈臵茖葛蛒裓觡諽輵轕镉閣閤阁隔革鞈鞷韐韚騔骼鬲鮯\n" +
+	"gén 哏\n" +
+	"gòng 共唝羾莻貢贡\n" +
+	"gòu 冓坸垢够夠姤媾彀搆撀构構煹茩覯觏訽詬诟購购遘雊\n" +
+	"gù 僱凅固堌崓崮故梏棝牿痼祻稒錮锢雇顧顾鯝鲴\n" +
+	"gùn 棍璭睔睴謴\n" +
+	"gú 鶻\n" +
+	"gā 呷嘎嘠旮\n" +
+	"gāi 侅垓姟峐晐畡祴絯荄該该豥賅賌赅郂陔\n" +
+	"gān 乹亁凲坩尲尴尶尷忓攼杆柑泔漧玕甘疳矸竿筸粓肝芉苷迀酐魐鳱\n" +
+	"gāng 冈冮刚剛堈堽岡掆杠棡牨犅疘矼綱纲缸罁罓罡肛釭鋼鎠钢\n" +
+	"gāo 槔槹橰櫜滜皋皐睾篙糕羔羙膏臯韟餻高髙鷎鷱鼛\n" +
+	"gē 仡割咯哥圪彁戈戓戨搁擱歌滒牫牱犵疙纥肐胳袼謌鎶鴐鴚鴿鸽鿔\n" +
+	"gēn 根跟\n" +
+	"gēng 刯庚椩浭焿畊絚緪縆羮羹耕菮賡赓鶊鹒\n" +
+	"gě 哿嗰舸\n" +
+	"gěi 給给\n" +
+	"gěn 艮\n" +
+	"gěng 哽埂峺挭梗綆绠耿莄郠骾鯁鲠\n" +
+	"gōng 供公功匑匔厷塨宫宮工幊弓恭愩攻杛熕碽糼肱蚣觥觵躬躳髸龏龔龚\n" +
+	"gōu 佝勾沟溝篝簼緱缑袧褠鈎鉤钩鞲韝\n" +
+	"gū 估呱咕唂姑嫴孤柧橭沽泒笟箍箛篐罛苽菇菰蛄觚軱軲轱辜酤鈲鮕鴣鸪\n" +
+	"gǎ 尕玍\n" +
+	"gǎi 忋改絠\n" +
+	"gǎn 仠感扞擀敢桿橄澉皯秆稈笴簳衦赶趕鰔鱤鳡\n" +
+	"gǎng 岗崗港\n" +
+	"gǎo 夰搞暠杲槀槁檺稁稾稿縞缟菒藁藳镐\n" +
+	"gǒng 巩廾拱拲栱汞珙輁鞏\n" +
+	"gǒu 岣枸狗玽笱耇耈耉芶苟蚼豿\n" +
+	"gǔ 傦古唃啒嘏夃尳愲扢榖榾毂汩淈濲瀔牯皷皼盬瞽穀糓縎罟羖股脵臌蓇薣蛊蛌蠱詁诂谷轂逧鈷钴餶馉骨鹄鹘鼓鼔\n" +
+	"gǔn 丨惃滚滾磙緄绲蓘蔉衮袞輥辊鮌鯀鲧\n" +
+	"hai 嚡\n" +
+	"han 兯爳\n" +
+	"hm 噷\n" +
+	"hui 懳\n" +
+	"huà 划劃化夻婳嫿嬅崋摦杹桦槬樺澅画畫畵繣舙觟話諙諣譮话黊\n" +
+	"huài 咶坏壊壞蘾\n" +
+	"huàn 唤喚喛奂奐宦嵈幻患愌换換擐梙槵浣涣渙漶澣烉焕煥瑍痪瘓睆肒藧豢逭鯇鯶鰀鲩\n" +
+	"huàng 愰曂榥滉皝皩鎤\n" +
+	"huá 华姡搳撶滑猾磆華蕐螖譁釪釫鋘鏵铧驊骅鷨\n" +
+	"huái 徊怀懐懷槐櫰淮瀤耲蘹褢褱踝\n" +
+	"huán 圜嬛寏寰峘桓洹澴狟环環瓛糫絙綄繯缳羦荁萈萑豲貆轘郇鉮鍰鐶锾镮闤阛雈鬟鹮\n" +
+	"huáng 偟凰喤堭墴媓崲徨惶楻湟潢煌熿獚瑝璜癀皇磺穔篁篊簧艎葟蝗蟥諻趪遑鍠鐄锽隍韹餭騜鰉鱑鳇鷬黃黄\n" +
+	"huì 会僡儶匯卉哕喙嘒噦嚖圚嬒孈寭屶屷彗彙彚徻恚恵惠慧憓晦暳會槥橞檅櫘殨汇泋浍湏滙潓澮濊烩燴獩璤璯瘣瞺秽穢篲絵繢繪绘缋翙翽芔荟蔧蕙薈薉藱蟪詯誨諱譓譿讳诲賄贿鏸鐬闠阓靧頮顪颒餯\n" +
+	"huí 佪囘回囬廻廽恛洄烠痐茴蚘蛔蛕蜖迴逥鮰\n" +
+	"huò 俰咟嚯嚿奯惑或捇掝旤曤楇檴沎湱濩瀖獲癨眓矆矐砉祸禍穫耯臛艧获蒦藿蠖謋貨货鑊镬閄霍靃\n" +
+	"huó 佸活秮秳\n" +
+	"huā 哗嘩埖婲椛硴糀花芲蒊蘤誮錵\n" +
+	"huān 嚾懽欢歓歡犿獾讙貛酄驩鴅鵍\n" +
+	"huāng 塃巟慌朚肓荒衁\n" +
+	"huī 咴噅噕婎媈幑徽恢拻挥揮撝晖暉楎洃瀈灰灳烣煇珲睳禈翚翬蘳虺袆褘詼诙豗輝辉隓隳鰴麾\n" +
+	"huō 剨劐吙嚄攉耠豁鍃锪騞\n" +
+	"huǎn 攌緩缓\n" +
+	"huǎng 兤奛宺幌怳恍晃晄櫎炾熀縨詤謊谎\n" +
+	"huǐ 悔檓毀毁毇燬譭\n" +
+	"huǒ 伙夥漷火邩鈥钬\n" +
+	"hài 亥嗐妎害氦餀饚駭駴骇\n" +
+	"hàn 傼垾屽岾悍憾捍撖撼旱晘暵汉汗涆漢瀚焊熯猂皔睅翰莟菡蘫蛿蜭螒譀釬銲鋎閈闬雗頷顄颔馯駻鶾\n" +
+	"hàng 沆\n" +
+	"hào 傐号哠恏悎昊昦晧暤暭曍浩淏滈澔灏灝皓皜皞皡皥秏耗聕薃號鄗鎬顥颢鰝\n" +
+	"há 蛤\n" +
+	"hái 孩还還頦骸\n" +
+	"hán 函凾含咁唅圅娢寒崡嵅晗梒浛涵澏焓琀甝筨肣虷蜬邗邯鋡韓韩魽\n" +
+	"háng 垳斻杭珩笐筕絎绗航苀蚢貥迒頏颃魧\n" +
+	"háo 儫嗥嘷噑嚎壕椃毜毫濠獆獋獔竓籇蚝蠔諕譹豪貉\n" +
+	"hè 佫嗃垎壑寉焃煂熇燺爀癋碋穒翯袔褐謞賀贺赫靍靎靏鶮鶴鸖鹤\n" +
+	"hèn 恨\n" +
+	"hèng 堼\n" +
+	"hé 何劾合咊和哬啝姀峆惒敆曷柇核楁毼河涸渮澕熆狢皬盇盉盍盒礉禾秴篕籺粭紇翮荷菏萂蚵螛覈訸詥貈輅郃鉌鑉闔阂阖鞨頜颌饸魺鲄鶡鹖麧齕龁龢\n" +
+	"hén 拫痕鞎\n" +
+	"héng 姮恆恒桁横橫烆胻蘅衡鑅鴴鵆鸻\n" +
+	"hòng 撔澋澒訌讧銾閧闀闂鬨\n" +
+	"hòu 候厚后垕堠後洉豞逅郈鮜鱟鲎鲘\n" +
+	"hóng 仜吰垬妅娂宏宖弘彋汯泓洪浤渱潂玒玜硔竑竤粠紅紘紭綋红纮翃翝耾苰荭葒葓蕻虹谹谼鈜鉷鋐閎闳霐霟鞃魟鴻鸿黉黌\n" +
+	"hóu 侯喉帿猴瘊睺矦篌糇翭翵葔鄇鍭餱骺鯸\n" +
+	"hù 乥互冱冴嗀嚛婟嫭嫮岵帍弖怘怙戶户戸戽扈护摢昈枑楛槴沍沪滬熩瓠祜笏簄粐綔芐蔰護鄠鍙雽韄頀鱯鳠鳸鸌鹱\n" +
+	"hùn 俒倱圂慁掍混溷焝觨諢诨\n" +
+	"hú 喖嘝囫壶壷壺媩弧抇搰斛楜槲湖瀫焀煳狐猢瑚瓳箶糊絗縠胡葫蔛蝴螜衚觳醐鍸隺頶餬鬍魱鰗鵠鶘鶦鹕\n" +
+	"hún 堚忶梡浑渾琿繉轋餛馄魂鼲\n" +
+	"hā 哈铪\n" +
+	"hāi 咍咳嗨\n" +
+	"hān 佄哻嫨憨歛蚶谽酣頇顸馠鼾\n" +
+	"hāng 夯\n" +
+	"hāo 嚆茠蒿薅薧\n" +
+	"hē 呵喝嗬抲欱蠚訶诃\n" +
+	"hēi 嘿潶黑黒\n" +
+	"hēng 亨哼啈悙涥脝\n" +
+	"hěn 佷很狠詪\n" +
+	"hōng 叿吽呍哄嚝揈渹灴烘焢硡薨訇谾軣輷轟轰鍧\n" +
+	"hōu 齁\n" +
+	"hū 乎乯匢匫呼唿嘑垀寣幠忽恗惚戯昒曶歑泘淴滹烀膴苸虍虖謼軤轷雐\n" +
+	"hūn 婚惛昏昬棔殙涽睧睯荤葷閽阍\n" +
+	"hǎ 奤\n" +
+	"hǎi 塰海烸胲酼醢\n" +
+	"hǎn 丆厈喊浫罕蔊豃阚鬫\n" +
+	"hǎo 好郝\n" +
+	"hǒng 嗊晎\n" +
+	"hǒu 吼犼\n" +
+	"hǔ 乕俿唬汻浒滸琥萀虎虝錿鯱\n" +
+	"jian 橺\n" +
+	"jiang 杢\n" +
+	"jiao 櫵鵤\n" +
+	"jing 燝\n" +
+	"jià 价價嫁幏架榢稼駕驾\n" +
+	"jiàn 件俴健僭剑剣剱劍劎劒劔墹寋建徤擶旔栫楗榗毽洊涧渐溅漸澗濺瀳牮珔瞷磵礀箭糋繝腱臶舰艦荐葥蔪薦螹袸見覵见諓諫譼谏賎賤贱趝践踐踺轞釼鉴鋻鍳鍵鏩鐱鑑鑒鑬鑳键餞饯\n" +
+	"jiàng 勥匞匠夅嵹弜弶彊摾櫤洚滰犟糡糨絳绛袶謽酱醤醬降\n" +
+	"jiào 叫呌嘂嘦噍噭嬓峤嶠挍敎教斠滘漖潐獥珓皭窌窖藠訆譥趭較轎轿较酵醮釂\n" +
+	"jiá 唊圿忦恝戛戞扴荚莢蛱蛺裌跲郏郟鋏铗頬頰颊餄鴶鵊\n" +
+	"jiè 丯介借吤堺屆届岕庎徣悈戒楐犗玠琾界畍疥砎芥蚧蛶衸褯誡诫鎅骱魪\n" +
+	"jié 倢偼傑刦刧刼劫劼卩卪婕媫孑尐岊崨嵥嶻巀幯截拮捷掶擮昅杰桀桝楬楶榤櫭洁滐潔疌睫碣礍竭節結絜结羯节莭蓵蜐蝍蠘蠞蠽衱袺訐詰誱讦踕迼鉣鍻鞊颉魝鮚鲒\n" +
+	"jiù 倃僦匓匛匶厩咎就廄廏廐慦捄救旧柩柾桕欍殧疚臼舅舊鯦鷲鹫麔齨\n" +
+	"jiā 乫伽佳傢加嘉埉夹夾家抸拁枷梜毠泇浃浹犌猳珈痂笳糘耞腵茄葭袈豭貑跏迦鉫鉿鎵镓麚\n" +
+	"jiān 兼冿囏坚堅奸姦姧尖幵惤戋戔搛椷椾樫櫼歼殱殲湔瀐瀸煎熞熸牋犍猏玪瑊监監睷碊礛笺箋篯緘縑缄缣肩艰艱菅菺葌蒹蕑蕳虃覸豜豣鐧鑯間间鞬鞯韀韉餰馢鰹鲣鳒鳽鵳鶼鹣麉\n" +
+	"jiāng 僵壃姜将將摪橿殭江浆漿畕畺疅疆礓繮缰翞茳葁薑螀螿豇韁鱂鳉\n" +
+	"jiāo 交僬嘄姣娇嬌峧嶕嶣憍椒浇澆焦燋礁穚簥胶膠膲艽芁茭茮蕉虠蛟蟭跤轇郊鐎驕骄鮫鲛鵁鷦鷮鹪\n" +
+	"jiē 喈喼嗟堦媘嫅接掲揭擑椄湝煯疖痎癤皆秸稭脻菨蝔街謯阶階鞂鶛\n" +
+	"jiě 姐媎檞毑解觧飷\n" +
+	"jiōng 冂冋坰埛扃絅蘏蘔駉駫\n" +
+	"jiū 丩勼啾揂揪揫摎朻樛牞究糺糾纠萛赳阄鬏鬮鳩鸠\n" +
+	"jiǎ 假婽岬徦斚斝椵榎槚檟玾甲瘕胛賈贾鉀钾\n" +
+	"jiǎn 俭倹儉减剪劗囝堿弿彅戩戬拣挸捡揀揃撿暕枧柬梘检検檢減湕瀽瑐睑瞼硷碱礆笕筧简簡籛絸繭翦茧藆蠒裥襇襉襺詃謇謭譾谫趼蹇鐗锏鬋鰎鹸鹻鹼\n" +
+	"jiǎng 傋奖奨奬桨槳獎耩膙蒋蔣講讲顜\n" +
+	"jiǎo 佼侥僥儌剿劋孂徺徼恔憿挢捁搅摷撟撹攪敫敽敿晈暞曒湫湬灚烄煍燞狡璬皎皦矫矯笅絞繳纐绞缴脚腳臫蟜角譑賋踋鉸铰隦餃饺鱎\n" +
+	"jiǒng 侰僒冏囧泂浻澃炅炯烱煚煛熲燛窘綗褧迥逈颎\n" +
+	"jiǔ 久乆九乣奺杦汣灸玖紤舏酒镹韭韮\n" +
+	"ju 爠\n" +
+	"juàn 倦劵勌奆巻慻桊淃狷獧眷睊睠絭絹縳绢罥羂蔨鄄隽雋飬餋\n" +
+	"jué 亅倔傕决刔劂勪匷厥噱嚼孒孓屫崛嶥弡彏憠憰戄抉挗捔掘攫斍桷橛橜欔欮殌氒決泬灍焳熦爑爝爴爵獗玃玦玨珏瑴疦瘚矍矡砄絕絶绝臄芵蕝蕨虳蚗蟨蟩覐覚覺觉觖觼訣譎诀谲貜赽趉趹蹶蹷躩逫鈌鐍鐝钁镢駃鴂鴃鶌鷢龣\n" +
+	"juān 勬姢娟捐涓焆瓹脧蠲裐鎸鐫镌鵑鹃\n" +
+	"juē 噘屩撅撧蹻\n" +
+	"juǎn 卷呟埍帣捲臇菤錈锩\n" +
+	"jì 伎偈兾冀剂剤劑哜嚌坖垍塈妓季寂寄峜廭彐彑徛忌悸惎懻技旡既旣暨暩曁梞檕檵洎济済漃漈濟瀱痵癠祭禝稩稷穄穊穧紀紒継繋繼纪继罽臮芰茍茤荠葪蓟蔇薊薺蘎蘮蘻裚覬觊計記誋諅计记跽际際霁霽驥骥髻鬾鯚鰶鰿鱀鱭鲚鲫鵋齌\n" +
+	"jìn 伒僸凚劤劲勁唫噤嚍墐壗妗嬧寖搢晉晋枃歏殣浕浸溍濅濜烬煡燼琎瑨璡璶祲禁縉缙荩藎覲觐賮贐赆近进進靳齽\n" +
+	"jìng 俓倞傹净凈境妌婙婧弪弳径徑敬曔桱梷浄淨瀞獍痉痙竞竟竧竫競竸胫脛誩踁迳逕鏡镜靓靖静靚靜\n" +
+	"jí 亟亼亽伋佶偮卙即卽及叝吉塉姞嫉岌嶯庴彶忣急愱戢揤极棘楫極槉橶檝殛汲湒潗濈焏狤疾瘠皀皍笈箿籍級级耤脊膌艥蒺蕀蕺藉螏襋觙诘谻趌踖蹐躤輯轚辑郆銡鍓鏶集雦雧霵鶺鷑鹡\n" +
+	"jù 乬俱倨倶具冣剧劇勮句埧埾壉姖寠屦屨岠巨巪怇怐怚惧愳懅懼拒拠据據昛歫洰澽炬烥犋秬窭窶簴粔耟聚苣虡蚷袓詎讵豦貗跙距踞躆遽邭醵鉅鋸鐻钜锯颶飓駏鮔\n" +
+	"jùn 俊儁呁埈寯峻懏捃攈攟晙棞浚濬焌燇珺畯竣箘箟蜠郡陖餕馂駿骏鵔鵕鵘\n" +
+	"jú 侷僪啹婅局巈桔椈橘檋毩毱泦淗湨焗犑狊粷菊蘜趜跼蹫躹輂郹閰駶驧鵙鵴鶪鼰鼳\n" +
+	"jī 丌乩僟击刉刏剞勣叽咭唧喞嗘嘰圾基墼姫姬屐嵆嵇撃擊敧朞机枅槣樭機櫅毄激犄玑璣畸畿癪矶磯禨积稘稽積笄筓箕簊緝績绩缉羁羇羈耭肌芨虀襀覉覊觭譏譤讥賫賷赍跡跻蹟躋躸迹鄿銈錤鐖鑇鑙隮雞鞿韲飢饑饥鳮鶏鷄鸄鸡齎齏齑\n" +
+	"jīn 今兓埐堻嶜巾惍斤津珒琻矜矝砛筋紟荕衿襟觔金釒釿钅鹶黅\n" +
+	"jīng 京亰兢坕坙婛巠惊旌旍晶橸泾涇猄睛秔稉粳精経經经聙腈茎荆荊莖菁葏驚鯨鲸鵛鶁鶄麖麠鼱\n" +
+	"jū 凥匊娵婮居崌抅拘挶掬梮椐泃涺狙琚疽痀眗砠罝腒艍苴菹蜛裾諊趄跔踘鋦锔陱雎鞠鞫駒驹鮈鴡鶋\n" +
+	"jūn 军君均姰桾汮皲皸皹碅莙菌蚐袀覠軍鈞銁銞鍕钧鮶鲪麇麏麕\n" +
+	"jǐ 丮几妀嵴己幾戟挤掎撠擠泲犱穖虮蟣魕魢鱾麂\n" +
+	"jǐn 仅侭僅儘卺厪堇嫤尽巹廑槿漌瑾盡紧緊菫蓳謹谨錦锦饉馑\n" +
+	"jǐng 丼井儆刭剄坓宑幜憬憼景暻汫汬璄璟璥穽肼蟼警阱頚頸颈\n" +
+	"jǔ 举咀弆挙擧椇榉榘櫸欅沮矩筥聥舉莒蒟襷踽齟龃\n" +
+	"kun 尡\n" +
+	"kuà 挎胯跨骻\n" +
+	"kuài 侩儈凷哙噲圦块塊墤巜廥快旝狯獪筷糩脍膾郐鄶鱠鲙\n" +
+	"kuàng 况卝圹壙岲懬旷昿曠況爌眖眶矌矿砿礦穬絋絖纊纩貺贶軦邝鄺鉱鋛鑛黋\n" +
+	"kuáng 忹抂狂狅誑诳軖軠鵟\n" +
+	"kuì 匮喟嘳媿嬇尯愦愧憒樻欳溃潰瞆篑簣籄聩聭聵腃蒉蕢謉鐀鑎餽饋馈\n" +
+	"kuí 喹夔奎巙戣揆晆暌楏楑櫆犪睽葵藈蘷虁蝰躨逵鄈鍨鍷隗頄頯馗騤骙魁\n" +
+	"kuò 廓懖扩拡括挄擴桰濶筈萿葀蛞闊阔霩鞟鞹韕頢髺鬠\n" +
+	"kuā 夸姱舿誇\n" +
+	"kuān 宽寛寬臗鑧髋髖\n" +
+	"kuāng 劻匡匩哐恇框洭硄筐筺誆诓軭邼\n" +
+	"kuī 亏刲岿巋悝盔窥窺聧蘬虧闚顝\n" +
+	"kuǎ 侉咵垮銙\n" +
+	"kuǎi 擓蒯\n" +
+	"kuǎn 欵款歀窽窾\n" +
+	"kuǎng 儣夼懭\n" +
+	"kuǐ 煃跬蹞頍\n" +
+	"kài 勓忾愒愾欬炌炏烗鎎\n" +
+	"kàn 墈崁看瞰矙磡衎闞\n" +
+	"kàng 亢伉匟囥抗炕犺邟鈧钪閌\n" +
+	"kào 犒銬铐靠鮳鯌鲓\n" +
+	"káng 扛摃\n" +
+	"kè 克刻勀勊堁娔客尅恪愙氪溘碦礊緙缂艐課课锞騍骒\n" +
+	"kèn 掯裉褃\n" +
+	"ké 壳揢殼翗\n" +
+	"kòng 控鞚\n" +
+	"kòu 冦叩宼寇扣敂滱瞉窛筘簆蔲蔻釦鷇\n" +
+	"kù 俈喾嚳库庫廤焅瘔秙絝绔袴裤褲趶酷\n" +
+	"kùn 困涃睏\n" +
+	"kā 咔咖喀擖衉\n" +
+	"kāi 奒开揩鐦锎開\n" +
+	"kān 刊勘堪嵁戡栞龕龛\n" +
+	"kāng 嫝嵻康忼慷槺漮砊穅粇糠躿鏮闶鱇\n" +
+	"kāo 尻髛\n" +
+	"kē 匼嗑嵙搕柯棵榼樖牁犐珂疴瞌砢磕礚科稞窠胢苛萪薖蝌趷軻轲醘鈳錒钶顆颏颗髁\n" +
+	"kēi 剋\n" +
+	"kēng 劥吭坑妔挳摼牼硁硜硻誙銵鍞鏗铿阬\n" +
+	"kě 可坷岢嵑嶱敤渇渴炣\n" +
+	"kěn 啃垦墾恳懇肎肯肻豤錹齦龈\n" +
+	"kōng 倥埪崆悾涳硿空箜躻錓鵼\n" +
+	"kōu 剾彄抠摳眍瞘芤\n" +
+	"kū 刳哭圐堀崫扝枯桍矻窟跍郀骷鮬\n" +
+	"kūn 坤堃堒婫崐崑昆晜潉焜熴猑琨瑻菎蜫裈裩褌貇醌錕锟騉髠髡髨鯤鲲鵾鶤鹍\n" +
+	"kǎ 佧卡垰胩裃鉲\n" +
+	"kǎi 凯凱剀剴嘅垲塏嵦恺愷慨暟楷蒈輆鍇鎧铠锴闓闿颽\n" +
+	"kǎn 侃偘冚坎埳塪惂槛檻欿歁砍竷莰輡轗顑\n" +
+	"kǎo 丂拷攷栲洘烤考\n" +
+	"kǒng 孔恐\n" +
+	"kǒu 劶口\n" +
+	"kǔ 狜苦\n" +
+	"kǔn 壸壼悃捆梱硱祵稇稛綑裍閫閸阃\n" +
+	"la 啦鞡\n" +
+	"lang 唥\n" +
+	"le 了餎饹\n" +
+	"lei 嘞\n" +
+	"liang 煷簗\n" +
+	"ling 瀮\n" +
+	"liàn 僆堜媡恋戀楝殓殮浰湅潋澰瀲炼煉瑓練纞练萰錬鍊鏈链鰊\n" +
+	"liàng 亮哴喨悢晾湸諒谅輌輛辆量鍄\n" +
+	"liào 尞尥尦廖撂料炓瞭窷镣\n" +
+	"lián 亷劆匲匳嗹噒奁奩嫾帘廉怜慩憐梿槤櫣涟溓漣濂濓熑燫磏簾籢籨縺翴联聨聫聮聯臁莲蓮薕螊蠊裢褳覝謰蹥连連鎌鐮镰鬑鰱鲢\n" +
+	"liáng 俍凉墚梁椋樑涼粮粱糧綡良踉輬辌\n" +
+	"liáo 僚嘹嫽寥寮屪嵺嶚嶛廫憀敹暸漻燎爎獠璙疗療竂簝繚缭聊膋膫藔蟟豂賿蹘辽遼鐐飉髎鷯鹩\n" +
+	"liè 儠冽列劣劽哷埒埓姴巤挒捩擸栵洌浖烈烮煭犣猎猟獵睙聗脟茢蛚裂趔躐迾颲鬛鬣鮤鱲鴷\n" +
+	"liù 六塯廇澑畂磟翏雡霤飂餾鬸鷚鹨\n" +
+	"liú 刘劉嚠媹嵧懰旈旒榴橊沠流浏瀏琉瑠瑬璢畄留畱疁瘤癅硫磂蒥蓅藰蟉裗遛鎏鎦鏐鐂镏镠飀飅飗馏駠駵騮驑骝鰡鶹鹠麍\n" +
+	"liāo 撩蹽\n" +
+	"liě 咧挘毟\n" +
+	"liū 溜熘蹓\n" +
+	"liǎ 俩倆\n" +
+	"liǎn 嬚摙敛斂琏璉羷脸臉蔹蘝蘞裣襝鄻\n" +
+	"liǎng 両两兩唡啢掚緉脼蜽裲魉魎\n" +
+	"liǎo 叾憭曢爒蓼鄝釕钌镽\n" +
+	"liǔ 嬼柳栁桞桺橮熮珋綹绺罶羀鉚鋶锍\n" +
+	"lo 囖\n" +
+	"lu 氇\n" +
+	"luàn 乱亂釠\n" +
+	"luán 圝圞奱娈孌孪孿峦巒挛攣曫栾欒滦灓灤癴癵羉脔臠虊銮鑾鵉鸞鸾\n" +
+	"luò 峈摞泺洛洜漯濼犖珞硦笿絡纙络荦落鉻雒駱骆鮥鴼鵅\n" +
+	"luó 儸攞椤欏猡玀箩籮罖羅脶腡萝蘿螺覙覶覼逻邏鏍鑼锣镙饠騾驘骡鸁\n" +
+	"luō 啰囉罗頱\n" +
+	"luǎn 卵\n" +
+	"luǒ 倮剆曪瘰癳臝蓏蠃裸躶\n" +
+	"là 揧攋楋溂爉瓎瘌腊臈臘蜡蝋蝲蠟辢辣鑞镴鬎鯻\n" +
+	"lài 唻櫴濑瀨瀬癞癩睐睞籁籟藾襰賚賴赉赖頼顂鵣\n" +
+	"làn 嚂滥濫烂燗爁爛爤瓓糷鑭\n" +
+	"làng 埌崀浪莨蒗閬\n" +
+	"lào 嗠嫪憦橯涝澇烙耢耮躼軂酪\n" +
+	"lá 剌嚹揦旯砬磖\n" +
+	"lái 來俫倈婡崃崍庲徕徠来梾棶涞淶猍琜筙箂莱萊逨郲錸铼騋鯠鶆麳\n" +
+	"lán 儖兰厱囒婪岚嵐幱惏懢拦攔斓斕栏欄欗澜瀾灆灡燣燷璼礷篮籃籣繿葻蓝藍蘭褴襕襤襴襽譋讕谰躝钄镧闌阑韊\n" +
+	"láng 勆嫏廊斏桹榔欴狼琅瑯硠稂筤艆蓈蜋螂躴郎郒郞鋃鎯锒阆駺鿶\n" +
+	"láo 僗劳労勞哰唠嘮崂嶗憥朥浶牢痨癆磱窂簩蟧醪鐒铹顟髝\n" +
+	"lè 乐仂叻忇扐楽樂氻泐玏砳竻簕艻阞韷鰳鳓\n" +
+	"lèi 攂泪洡涙淚禷类累纇蘱酹銇錑頛頪類颣\n" +
+	"lèng 倰堎愣睖踜\n" +
+	"léi 儽壨嫘擂檑櫑欙瓃畾礌礧縲纍纝缧罍羸蔂蘲虆轠鐳鑘镭雷靁鱩鼺\n" +
+	"léng 塄崚棱楞碐稜薐輘\n" +
+	"lì 丽例俐俪傈儮儷凓利力励勵历厉厤厯厲吏呖唎唳嚦囇坜塛壢娳婯屴岦巁悧悷慄戾搮攊攦攭暦曆曞朸枥栃栎栗栛棙檪櫔櫟櫪欐歴歷沥沴涖溧濿瀝爄爏犡猁珕瑮瓅瓑瓥疠疬痢癘癧皪盭砅砺砾磿礪礫礰禲秝立笠篥粒粝糲綟脷苈苙茘荔莅莉蒚蒞藶蚸蛎蛠蜧蝷蠇蠣觻詈讈赲跞躒轢轣轹郦酈鉝鎘隶隷隸雳靂靋鬁鱱鱳鳨鴗鷅麗麜\n" +
+	"lìn 僯吝恡悋橉焛甐疄膦蔺藺賃赁蹸躏躙躪轥閵\n" +
+	"lìng 令另呤炩\n" +
+	"lí 刕剓剺劙厘喱嚟囄嫠孋孷廲悡斄杝梨梩梸棃樆漓灕犁犂狸琍璃瓈盠睝离穲竰筣篱籬糎縭纚缡罹艃荲菞蓠蔾藜蘺蜊蟍蠡蠫褵謧貍邌醨鋫錅鏫鑗離驪骊鯏鯬鱺鲡鵹鸝鹂黎黧\n" +
+	"lín 临冧厸啉壣崊嶙斴晽暽林淋潾瀶燐獜琳璘痳瞵碄磷箖粦粼繗翷臨轔辚遴邻鄰鏻隣霖驎鱗鳞麐麟\n" +
+	"líng 伶凌刢囹坽夌姈婈孁岺彾掕昤朎柃棂櫺欞泠淩澪灵燯爧狑玲琌瓴皊砱祾秢竛笭紷綾绫羚翎聆舲苓菱蓤蔆蕶蘦蛉衑裬詅跉軨酃醽鈴錂铃閝陵零霊霗霛霝靈駖魿鯪鲮鴒鸰鹷麢齡齢龄龗\n" +
+	"lòng 哢徿梇贚\n" +
+	"lòu 屚漏瘘瘺瘻鏤镂陋\n" +
+	"lóng 咙嚨屸嶐巃巄昽曨朧栊槞櫳泷湰滝漋瀧爖珑瓏癃眬矓砻礱礲窿竜笼篭籠聋聾胧茏蕯蘢蠪蠬襱豅躘鏧鑨隆霳靇驡鸗龍龒龙\n" +
+	"lóu 偻僂剅喽嘍娄婁廔慺楼樓溇漊熡耧耬艛蒌蔞蝼螻謱軁遱鞻髅髏\n" +
+	"lù 侓僇剹勎勠圥坴塶娽峍廘彔录戮摝椂樚淕淥渌漉潞熝琭璐甪盝睩硉碌祿禄稑穋箓簏簬簵簶籙粶膔菉蔍蕗虂螰觮賂赂趢路踛蹗轆辂辘逯醁錄録錴鏕鏴陆陸露騄騼鯥鵦鵱鷺鹭鹿麓\n" +
+	"lùn 溣論论\n" +
+	"lú 卢嚧垆壚庐廬攎曥枦栌櫨泸瀘炉爐獹玈璷瓐盧矑籚纑罏胪臚舮舻艫芦蘆蠦轤轳鈩鑪顱颅髗魲鱸鲈鸕鸬黸\n" +
+	"lún 仑伦侖倫囵圇婨崘崙惀棆沦淪磮綸纶腀菕蜦踚輪轮錀陯鯩\n" +
+	"lüè 圙掠擽略畧稤鋝鋢锊\n" +
+	"lā 垃拉搚柆翋菈邋\n" +
+	"lāng 啷\n" +
+	"lāo 捞撈粩\n" +
+	"lē 肋\n" +
+	"lēi 勒\n" +
+	"lěi 傫儡厽垒塁壘樏櫐灅癗矋磊磥礨絫耒腂蕌蕾藟蘽蠝誄讄诔鑸鸓\n" +
+	"lěng 冷\n" +
+	"lī 哩\n" +
+	"līn 拎\n" +
+	"lōu 瞜\n" +
+	"lū 噜撸謢\n" +
+	"lūn 抡掄\n" +
+	"lǎ 喇藞\n" +
+	"lǎn 囕壈嬾孄孏懒懶揽擥攬榄欖浨漤灠爦纜缆罱覧覽览醂顲\n" +
+	"lǎng 塱朖朗朤樃烺蓢誏\n" +
+	"lǎo 佬咾姥恅栳橑潦狫珯硓老耂荖蛯轑銠铑鮱\n" +
+	"lǐ 俚兣娌峛峢峲李欚浬澧理礼禮粴蟸裏裡豊逦邐醴里鋰锂鯉鱧鲤鳢\n" +
+	"lǐn 亃凛凜廩廪懍懔撛檁檩澟癛癝菻\n" +
+	"lǐng 岭嶺袊阾領领\n" +
+	"lǒng 儱垄垅壟壠拢攏竉篢陇隴龓\n" +
+	"lǒu 塿嵝嶁搂摟甊篓簍\n" +
+	"lǔ 卤嚕塷掳擄擼樐橹櫓氌滷澛瀂硵磠艣艪蓾虏虜鏀鐪鑥镥魯鲁鹵\n" +
+	"lǔn 埨碖稐耣\n" +
+	"lǘ 榈櫚氀膢藘閭闾馿驢驴鷜\n" +
+	"lǚ 侣侶儢吕呂屡屢履挔捋捛旅梠焒祣稆穞穭絽縷缕膂膐褛褸郘鋁铝\n" +
+	"lǜ 勴垏寽嵂律慮櫖氯滤濾爈率箻綠緑繂绿膟葎虑鑢\n" +
+	"ma 亇吗嗎嘛嫲\n" +
+	"me 么嚜濹癦麼\n" +
+	"men 们們\n" +
+	"meng 掹\n" +
+	"min 垊\n" +
+	"ming 掵\n" +
+	"miàn 糆面靣麪麫麵麺\n" +
+	"miào 妙庙庿廟玅竗\n" +
+	"mián 婂媔嬵宀杣棉檰櫋眠矈矊矏綿緜绵臱芇蝒\n" +
+	"miáo 媌嫹描瞄緢苗鱙鶓鹋\n" +
+	"miè 幭懱搣櫗滅灭烕篾蔑薎蠛衊覕鑖鱴鴓\n" +
+	"miù 謬谬\n" +
+	"miāo 喵\n" +
+	"miē 乜吀咩哶孭\n" +
+	"miǎn 丏偭免冕勉勔喕娩愐汅沔渑湎澠眄絻緬缅腼葂鮸黽黾\n" +
+	"miǎo 杪淼渺眇秒篎緲缈藐邈\n" +
+	"mo 怽麿\n" +
+	"mà 傌唛嘜杩榪犸獁睰礣祃禡罵閁駡骂鬕\n" +
+	"mài 佅劢勱卖売脈脉衇賣迈邁霡霢麥麦鿏鿺\n" +
+	"màn 墁幔慢摱曼槾漫澷熳獌縵缦蔄蔓蘰鄤鏝镘\n" +
+	"mào 冃冐冒媢帽愗懋暓柕楙毷瑁皃眊瞀耄芼茂萺蝐袤覒貌貿贸鄚鄮\n" +
+	"má 犘痲蔴蟆蟇麻\n" +
+	"mái 埋薶霾\n" +
+	"mán 僈姏悗慲樠瞒瞞蛮蠻謾谩蹒鞔顢饅馒鬗鬘鰻鳗\n" +
+	"máng 吂哤娏尨庬忙恾杗杧氓汒浝牻狵痝盲硭笀芒茫蛖邙釯鋩铓駹\n" +
+	"máo 兞堥旄枆毛氂渵牦犛矛罞茅茆蝥蟊軞酕錨锚髦髳鶜\n" +
+	"mèi 妹媚寐抺旀昧沬煝痗眛睸祙篃蝞袂跊韎鬽魅\n" +
+	"mèn 悶懑懣暪焖燜闷\n" +
+	"mèng 夢夣孟梦霥\n" +
+	"méi 呅坆堳塺娒媒嵋徾攗枚栂梅楣楳槑沒没湄湈煤猸玫珻瑂眉睂矀禖穈脄脢腜苺莓葿蘪郿酶鋂鎇镅霉鶥鹛黴\n" +
+	"mén 亹扪捫玧璊菛虋鍆钔門閅门\n" +
+	"méng 儚冡幪懞曚朦橗檬氋溕濛甍甿盟瞢矇矒礞艨莔萌蒙蕄蘉虻蝱鄳鄸霿靀顭饛鯍鸏鹲鼆\n" +
+	"mì 冖冪嘧塓宓宻密峚幂幎幦榓樒櫁汨沕泌淧滵漞濗熐祕秘簚糸羃蔤藌蜜覓覔覛觅謐谧鼏\n" +
+	"mìng 命椧詺\n" +
+	"mí 冞弥彌戂擟攠瀰爢猕獼瓕祢禰糜縻蒾蘼袮詸謎谜迷醚醾醿釄镾靡鸍麊麋麛\n" +
+	"mín 姄岷崏忞怋捪旻旼民珉琘琝瑉痻盿砇碈緍緡缗罠苠鈱錉鍲鴖\n" +
+	"míng 冥名嫇明暝朙榠洺溟猽眀眳瞑茗蓂螟覭鄍銘铭鳴鸣\n" +
+	"mò 劰唜嗼圽塻墨妺嫼寞帓帞昩暯末枺歾歿殁沫湐漠瀎爅獏瘼皌眜眽眿瞐瞙砞礳秣粖絈纆耱茉莈莫蓦藦蛨蟔貃貊貘銆鏌镆陌靺驀魩默黙\n" +
+	"mó 劘嚤嚩嚰嫫尛庅摩摹擵模橅磨糢膜蘑謨謩谟饃饝馍髍魔魹麽\n" +
+	"móu 侔劺恈洠牟眸瞴繆缪蛑謀谋踎鉾鍪鴾麰\n" +
+	"mù 仫凩募墓幕幙慔慕暮木朰楘毣沐炑牧狇目睦穆縸艒苜莯蚞鉬钼雮霂鞪\n" +
+	"mú 墲毪氁\n" +
+	"mā 妈媽嬤嬷孖\n" +
+	"mān 嫚颟\n" +
+	"māng 牤\n" +
+	"māo 猫貓\n" +
+	"mē 嚒\n" +
+	"mēn 椚\n" +
+	"mēng 擝\n" +
+	"měi 凂媄媺嬍嵄挴毎每浼渼燘美躾鎂镁黣\n" +
+	"měng 勐懜懵猛獴瓾艋蜢蠓錳锰鯭\n" +
+	"mī 咪眯瞇\n" +
+	"mō 摸\n" +
+	"mōu 哞\n" +
+	"mǎ 溤玛瑪码碼蚂螞遤鎷馬马鰢鷌\n" +
+	"mǎi 买嘪荬蕒買鷶\n" +
+	"mǎn 屘満满滿睌矕螨蟎襔鏋\n" +
+	"mǎng 壾漭硥茻莽莾蟒蠎\n" +
+	"mǎo 乮冇卯夘峁戼昴泖笷蓩铆\n" +
+	"mǐ 侎孊弭敉沵洣渳濔灖眫米粎羋脒芈葞蔝銤\n" +
+	"mǐn 僶冺刡勄悯惽愍慜憫抿敃敏敯暋泯湣潣皿笢笽簢蠠閔閩闵闽鰵鳘\n" +
+	"mǐng 佲凕姳慏酩\n" +
+	"mǒ 懡抹\n" +
+	"mǒu 某\n" +
+	"mǔ 亩坶姆峔拇母牡牳畆畒畝畞畮砪胟踇鉧\n" +
+	"ne 呢\n" +
+	"nin 脌\n" +
+	"niàn 卄唸埝姩廿念艌\n" +
+	"niàng 酿醸釀\n" +
+	"niào 尿脲\n" +
+	"nián 哖年秊秥鮎鯰鲇鲶鵇黏\n" +
+	"niáng 娘嬢孃\n" +
+	"niè 啮喦嗫噛嚙囁囓圼孼孽嵲嶭巕帇惗摰敜枿槷櫱涅湼痆篞籋糱糵聂聶臬臲菍蘖蠥讘踂踗踙蹑躡錜鎳鑈鑷钀镊镍闑陧隉顳颞齧\n" +
+	"nié 苶\n" +
+	"niú 汼牛牜\n" +
+	"niān 拈蔫\n" +
+	"niē 捏揑\n" +
+	"niū 妞\n" +
+	"niǎn 捻撚撵攆涊淰焾碾簐跈蹍蹨躎輦辇辗\n" +
+	"niǎo 嫋嬝嬲樢茑蔦袅裊褭鳥鸟\n" +
+	"niǔ 忸扭炄狃紐纽莥鈕钮靵\n" +
+	"nuán 奻\n" +
+	"nuò 喏愞懦懧掿搦搻榒稬穤糑糥糯諾诺蹃逽锘\n" +
+	"nuó 傩儺挪梛郍\n" +
+	"nuǎn 暖渜煖煗餪\n" +
+	"nuǒ 橠\n" +
+	"nà 吶呐妠娜捺笝納纳肭蒳衲袦豽貀軜那鈉钠靹魶\n" +
+	"nài 奈柰渿耏耐萘螚褦錼鼐\n" +
+	"nàn 婻\n" +
+	"nàng 儾齉\n" +
+	"nào 婥淖臑閙闹鬧\n" +
+	"ná 嗱拏拿挐鎿镎\n" +
+	"nái 孻摨熋腉\n" +
+	"nán 侽南喃娚抩暔枏柟楠男畘莮諵遖难難\n" +
+	"náng 乪嚢囊欜蠰譨饢馕鬞\n" +
+	"náo 呶夒峱嶩巎怓憹挠撓猱硇碙蛲蟯詉譊鐃铙\n" +
+	"nè 抐疒眲訥讷\n" +
+	"nèi 內内氝錗\n" +
+	"nèn 嫩嫰恁\n" +
+	"néng 能\n" +
+	"nì 伲匿堄嫟嬺屰惄愵昵暱氼溺眤睨縌胒腻膩誽迡逆\n" +
+	"nìng 佞侫倿泞澝濘\n" +
+	"ní 倪坭埿婗尼屔怩棿泥淣猊秜籾聣腝臡蚭蜺觬貎跜輗郳铌霓鯢鲵麑齯\n" +
+	"nín 囜您\n" +
+	"níng 儜凝咛嚀嬣宁寍寕寗寜寧拧擰柠檸狞獰甯聍聹苧薴鑏鬡鸋\n" +
+	"nòng 弄挊挵癑齈\n" +
+	"nòu 槈檽獳耨譳鎒鐞\n" +
+	"nóng 侬儂农哝噥檂欁浓濃燶禯秾穠脓膿蕽襛農辳醲\n" +
+	"nóu 羺\n" +
+	"nù 傉怒搙\n" +
+	"nú 奴孥笯駑驽\n" +
+	"nún 黁\n" +
+	"nüè 疟瘧硸虐\n" +
+	"nān 囡\n" +
+	"nāng 囔\n" +
+	"nāo 孬\n" +
+	"něi 娞脮腇餒馁鮾鯘\n" +
+	"nī 妮\n" +
+	"nǎ 乸哪雫\n" +
+	"nǎi 乃倷奶妳嬭廼氖疓艿迺釢\n" +
+	"nǎn 戁揇湳煵腩萳蝻赧\n" +
+	"nǎng 擃攮曩灢\n" +
+	"nǎo 匘垴堖嫐恼悩惱獶獿瑙碯脑脳腦\n" +
+	"nǐ 伱你儗儞孴抳拟擬旎晲柅檷狔聻苨薿鈮隬馜鿭\n" +
+	"nǐn 拰\n" +
+	"nǐng 橣矃\n" +
+	"nǒng 繷\n" +
+	"nǒu 啂\n" +
+	"nǔ 伮努弩砮胬\n" +
+	"nǚ 女籹釹钕\n" +
+	"nǜ 恧朒沑衂衄\n" +
+	"piàn 片騗騙骗魸\n" +
+	"piào 僄勡嘌徱漂票\n" +
+	"pián 楄楩胼腁諚谝賆跰蹁駢騈骈骿\n" +
+	"piáo 嫖瓢薸闝\n" +
+	"piè 嫳\n" +
+	"piān 偏囨媥犏篇翩鍂鶣\n" +
+	"piāo 剽彯慓旚犥缥翲螵飃飄飘魒\n" +
+	"piē 撆撇暼氕瞥\n" +
+	"piě 丿苤鐅\n" +
+	"piǎn 覑諞貵\n" +
+	"piǎo 殍皫瞟篻縹醥顠\n" +
+	"po 桲\n" +
+	"pu 巬巭\n" +
+	"pà 帊帕怕袙\n" +
+	"pài 哌派渒湃蒎鎃\n" +
+	"pàn 冸判叛拚沜泮溿炍牉畔盼聁袢襻詊鋬鑻頖鵥\n" +
+	"pàng 炐肨胖\n" +
+	"pào 奅泡炮疱皰砲礟礮麭\n" +
+	"pá 掱杷潖爬琶筢\n" +
+	"pái 俳徘排棑牌犤猅簰簲輫\n" +
+	"pán 媻幋搫槃洀瀊爿盘盤磐磻縏蒰蟠跘蹣鎜鞶\n" +
+	"páng 厐厖嫎庞徬旁舽螃逄鳑龎龐\n" +
+	"páo 刨匏咆垉庖炰爮狍袍褜軳鞄麃麅\n" +
+	"pèi 伂佩姵嶏帔斾旆沛浿珮蓜轡辔配霈馷\n" +
+	"pèn 喯\n" +
+	"pèng 掽椪碰踫\n" +
+	"péi 培毰裴裵賠赔锫阫陪駍\n" +
+	"pén 湓瓫盆葐\n" +
+	"péng 倗堋塳弸彭憉挷朋棚椖槰樥熢硼稝竼篣篷纄膨芃莑蓬蘕蟚蟛輣錋鑝韸韼騯髼鬅鬔鵬鹏\n" +
+	"pì 僻嚊媲嫓屁揊淠潎澼甓疈睥稫譬辟釽闢鷿鸊\n" +
+	"pìn 汖牝聘\n" +
+	"pí 啤埤壀岯崥朇枇毗毘毞焷狓琵疲皮篺罴羆肶脾腗膍芘蚍蚽蚾蜱螷蠯豼貔郫阰陴魮鲏鵧鼙\n" +
+	"pín 嚬娦嫔嬪玭琕矉薲蠙貧贫頻顰频颦\n" +
+	"píng 凭凴呯坪塀屏屛岼帡帲幈平慿憑枰檘泙洴淜焩玶瓶甁箳簈缾胓苹荓萍蓱蘋蚲蛢評评軿輧郱鮃鲆\n" +
+	"pò 岶敀昢洦烞珀破砶粕蒪迫酦醗釙魄\n" +
+	"pó 嘙婆櫇皤蔢謈鄱\n" +
+	"póu 抔抙捊掊箁裒錇\n" +
+	"pù 曝瀑舖舗鋪铺\n" +
+	"pú 僕匍圤墣濮獛璞瞨穙纀脯莆菐菩葡蒱蒲贌酺鏷镤\n" +
+	"pā 啪妑皅舥葩趴\n" +
+	"pāi 拍\n" +
+	"pān 攀潘畨眅萠\n" +
+	"pāng 乓沗滂胮膖雱霶\n" +
+	"pāo 抛拋脬萢\n" +
+	"pēi 呸怌柸肧胚衃醅\n" +
+	"pēn 喷噴歕\n" +
+	"pēng 匉嘭怦恲抨梈漰澎烹砰硑磞軯閛\n" +
+	"pěi 俖\n" +
+	"pěn 呠翸\n" +
+	"pěng 剻捧淎皏\n" +
+	"pī 丕伓伾劈噼坯悂憵批披抷旇炋狉砒磇礔礕秛秠紕纰翍耚豾邳鈈鈚鈹鉟銔錃錍铍霹駓髬魾鮍\n" +
+	"pīn 姘拼礗穦馪驞\n" +
+	"pīng 乒俜娉涄甹砯竮聠艵頩\n" +
+	"pō 坡岥泊泼溌潑鉕鏺钋頗\n" +
+	"pōu 剖娝\n" +
+	"pū 仆噗扑撲擈攴攵潽炇陠鯆\n" +
+	"pǎi 廹\n" +
+	"pǎng 嗙耪覫\n" +
+	"pǎo 跑\n" +
+	"pǐ 仳匹噽嚭圮庀擗疋痞癖脴苉諀銢鴄\n" +
+	"pǐn 品榀\n" +
+	"pǒ 叵尀笸钷颇駊\n" +
+	"pǒu 咅哣婄犃\n" +
+	"pǔ 圃圑普暜朴樸檏氆浦溥烳諩譜谱蹼鐠镨\n" +
+	"qi 簯緕缼\n" +
+	"qian 籖鎆鏲\n" +
+	"qing 硘\n" +
+	"qià 冾圶帢恰愘殎洽硈髂\n" +
+	"qiàn 俔倩傔儙刋堑塹壍嬱嵌悓慊棈椠槧欠歉皘篏篟綪縴芡茜蒨蔳輤鰜\n" +
+	"qiàng 唴炝熗羻\n" +
+	"qiào 俏僺峭帩撬撽殻窍竅翘翹誚譙诮躈陗鞘鞩韒髚\n" +
+	"qiá 拤\n" +
+	"qián 乾仱偂前墘媊岒忴扲拑掮揵榩橬歬潛潜濳灊箝羬蕁虔軡鈐鉗銭錢钤钱钳靬騚騝鰬黔黚\n" +
+	"qiáng 丬墙墻嫱嬙廧強强樯檣漒牆艢蔃蔷薔蘠\n" +
+	"qiáo 乔侨僑喬嘺嫶憔桥槗樵橋犞癄瞧硚礄荍荞菬蕎藮谯趫鐈鞒鞽顦\n" +
+	"qiè 切匧厒妾怯悏惬愜挈朅洯淁穕窃竊笡箧篋籡緁藒蛪踥郄鍥鐑锲鯜\n" +
+	"qié 癿聺\n" +
+	"qióng 儝卭宆惸憌桏橩焪焭煢熍琼璚瓊瓗睘瞏穷穹窮竆笻筇舼茕藑藭蛩蛬赹跫邛銎\n" +
+	"qiú 俅叴唒囚崷巯巰扏梂殏毬求汓泅浗渞湭煪犰玌球璆皳盚紌絿肍莍虬虯蛷蝤裘觓觩訄訅賕赇逎逑遒酋醔釓釚釻銶鮂鯄鰽鼽\n" +
+	"qiā 掐葜袷\n" +
+	"qiān 仟佥僉兛千圱圲奷婜孅孯岍悭愆慳扦拪掔搴撁攐攑攓杄檶櫏欦汘汧牵牽瓩竏签箞簽籤粁臤芊茾蚈褰諐謙谦谸迁遷釺鈆鉛钎铅阡雃韆顅騫骞鬜鬝鵮鹐\n" +
+	"qiāng 呛嗆嗴嶈戕戗戧斨枪椌槍溬牄猐獇玱瑲篬羌羗羫腔蜣謒跄蹌蹡錆鎗鏘锖锵镪\n" +
+	"qiāo 劁墝墽嵪幧悄敲橇毃燆硗磽繑缲趬跷踍蹺郻鄡鄥鍫鍬鐰锹頝骹\n" +
+	"qiē 苆\n" +
+	"qiě 且\n" +
+	"qiōng 芎\n" +
+	"qiū 丘丠坵媝恘楸秋秌穐篍緧萩蓲蘒蚯蝵蟗蠤趥邱鞦鞧鰌鰍鳅鶖鹙龝\n" +
+	"qiǎ 峠跒酠鞐\n" +
+	"qiǎn 凵嗛嵰槏浅淺繾缱肷脥膁蜸譴谴遣鑓\n" +
+	"qiǎng 墏抢搶繈繦羟羥襁鏹\n" +
+	"qiǎo 巧愀釥髜\n" +
+	"qiǔ 搝糗\n" +
+	"qu 迲\n" +
+	"quan 椦\n" +
+	"quàn 券劝勧勸牶韏\n" +
+	"quán 佺全啳埢姾婘孉巏惓拳搼权楾権權泉洤湶牷犈瑔痊硂筌絟縓荃葲蜷蠸觠詮诠跧踡輇辁醛銓铨闎顴颧騡鬈鰁鳈齤\n" +
+	"què 却卻埆塙墧崅悫愨慤搉榷燩琷皵硞确碏確碻礐礭趞闋闕阕雀鵲鹊\n" +
+	"qué 瘸\n" +
+	"quān 圈圏奍峑弮恮悛棬鐉駩\n" +
+	"quē 缺蒛阙\n" +
+	"quǎn 汱烇犬犭畎綣绻虇\n" +
+	"qì 呮咠唭噐器夡契弃忔憇憩摖暣栔棄欫气気氣汔汽泣湆湇炁甈盵矵砌碛碶磜磧磩罊芞葺蟿訖讫迄鼜\n" +
+	"qìn 吢吣唚抋揿搇撳沁瀙菣藽\n" +
+	"qìng 儬凊庆慶掅櫦殸濪碃磬箐罄謦靘\n" +
+	"qí 亓亝俟其剘圻埼奇岐岓崎嵜帺忯愭懠掑斉斊旂旗棊棋檱櫀歧淇濝猉玂琦琪璂畦疧碁碕祁祇祈祺禥竒簱籏粸綥綦綨纃耆肵脐臍艩芪萁萕蕲藄蘄蚑蚔蚚蛴蜝蜞螧蠐褀跂踑軝釮錡锜頎颀騎騏騹骐骑鬐鬿鯕鰭鲯鳍鵸鶀麒麡齊齐\n" +
+	"qín 勤嗪噙埁嫀庈慬懃懄捦擒斳檎溱澿珡琴琹瘽禽秦耹芩芹菦菳蚙螓蠄鈙鈫雂靲鬵鳹鵭\n" +
+	"qíng 剠勍夝情擎擏晴暒棾樈檠殑氰甠葝黥\n" +
+	"qù 刞厺去呿唟耝覷觑趣閴闃阒麮鼁\n" +
+	"qú 佢劬忂戵斪朐欋氍淭渠灈璖璩癯瞿磲籧絇翑胊臞菃葋蕖蘧螶蟝蠷蠼衐衢躣軥鑺鴝鸜鸲鼩\n" +
+	"qún 宭帬羣群裙裠\n" +
+	"qī 七倛僛凄嘁妻娸悽慼慽戚捿攲期柒栖桤桼棲榿槭欺沏淒漆紪緀萋蛣褄諆諿蹊迉郪鏚霋魌鶈\n" +
+	"qīn 亲侵媇寴嵚嶔欽綅衾親誛钦顉駸骎鮼\n" +
+	"qīng 倾傾卿圊埥寈氢氫淸清蜻輕轻郬鑋靑青鲭\n" +
+	"qū 伹佉匤区區坥屈岖岨岴嶇憈抾敺曲浀祛筁粬紶胠蛆蛐袪覰覻詘誳诎趋趨躯軀镼阹駆駈驅驱髷魼鰸鱋麯麴麹黢\n" +
+	"qūn 囷夋峮逡\n" +
+	"qǐ 乞企启呇唘啓啔啟婍屺岂晵杞棨玘盀綮綺绮芑諬豈起邔闙\n" +
+	"qǐn 坅寑寝寢昑梫笉螼赾鋟锓\n" +
+	"qǐng 庼廎檾漀苘請请頃顷\n" +
+	"qǔ 取娶竘竬蝺詓齲龋\n" +
+	"rong 穃\n" +
+	"ru 嶿\n" +
+	"ruá 挼\n" +
+	"ruán 堧壖撋\n" +
+	"ruì 叡壡枘汭瑞睿芮蚋蜹銳鋭锐\n" +
+	"ruí 婑桵甤緌蕤\n" +
+	"ruò 偌叒嵶弱楉渃焫爇箬篛若蒻鄀鰙鰯鶸\n" +
+	"ruó 捼\n" +
+	"ruǎn 偄媆朊瑌瓀碝礝緛耎軟輭软阮\n" +
+	"ruǐ 橤繠蕊蕋蘂蘃\n" +
+	"ràng 懹譲讓让\n" +
+	"rào 繞绕遶\n" +
+	"rán 呥嘫然燃繎肰蚦蚺衻袇袡髥髯\n" +
+	"ráng 儴勷瀼獽瓤禳穣穰蘘躟鬤\n" +
+	"ráo 娆嬈桡橈荛蕘襓饒饶\n" +
+	"rè 热熱\n" +
+	"rèn 仞仭任刃刄妊姙屻岃扨杒梕牣祍紉紝絍纫纴肕腍葚衽袵訒認认讱軔轫靭靱韌韧飪餁饪\n" +
+	"rèng 芿\n" +
+	"rén 人亻仁壬忈忎朲秂芢鈓銋魜鵀\n" +
+	"réng 仍礽辸陾\n" +
+	"rì 囸日釰鈤馹驲\n" +
+	"ròu 宍肉\n" +
+	"róng 媶嫆嬫容峵嵘嵤嶸巆戎搈搑曧栄榕榮榵毧溶瀜烿熔爃狨瑢穁絨縙绒羢肜茙荣蓉蝾融螎蠑褣鎔镕駥髶\n" +
+	"róu 厹媃揉柔渘煣瑈瓇禸粈糅腬葇蝚蹂輮鍒鞣騥鰇鶔\n" +
+	"rù 入嗕媷扖杁洳溽縟缛蓐褥鳰\n" +
+	"rùn 橍润潤膶閏閠闰\n" +
+	"rú 侞儒嚅如嬬孺帤曘桇渪濡燸筎茹蒘蕠薷蝡蠕袽襦邚醹銣铷顬颥鱬鴑鴽\n" +
+	"rún 瞤\n" +
+	"rēng 扔\n" +
+	"rě 惹\n" +
+	"rěn 忍栠栣棯秹稔綛荏荵躵\n" +
+	"rōng 茸\n" +
+	"rǎn 冄冉姌媣染橪珃苒蒅\n" +
+	"rǎng 嚷壌壤攘爙纕\n" +
+	"rǎo 扰擾隢\n" +
+	"rǒng 傇冗坈宂氄軵\n" +
+	"rǒu 楺韖\n" +
+	"rǔ 乳擩汝肗辱鄏\n" +
+	"san 壭橵\n" +
+	"sha 繌\n" +
+	"shang 裳\n" +
+	"shi 佦匙篒籂\n" +
+	"shou 扌\n" +
+	"shui 氵閖\n" +
+	"shuà 誜\n" +
+	"shuài 卛帅帥蟀\n" +
+	"shuàn 涮腨\n" +
+	"shuàng 灀\n" +
+	"shuì 帨涗涚睡瞓祱稅税裞\n" +
+	"shuí 脽誰\n" +
+	"shuò 妁搠朔槊欶烁爍獡矟硕碩箾蒴鎙鑠铄\n" +
+	"shuā 刷唰\n" +
+	"shuāi 摔衰\n" +
+	"shuān 拴栓閂闩\n" +
+	"shuāng 双孀孇欆礵艭雙霜騻驦骦鷞鸘鹴\n" +
+	"shuō 哾說説说\n" +
+	"shuǎ 耍\n" +
+	"shuǎi 甩\n" +
+	"shuǎng 塽慡樉漺爽縔鏯\n" +
+	"shuǐ 水氺\n" +
+	"shà 倽厦唼啑啥喢帹廈歃箑翜翣萐閯霎\n" +
+	"shài 晒曬閷\n" +
+	"shàn 傓僐剡善墠墡嬗扇掞擅敾椫樿歚汕潬灗疝磰繕缮膳蟮蟺訕謆譱讪贍赡赸鄯釤銏鐥饍騸骟鱓鱔鳝\n" +
+	"shàng 丄上尙尚恦緔绱鞝\n" +
+	"shào 劭卲哨娋潲睄紹綤绍袑邵\n" +
+	"sháo 勺柖玿芍苕韶\n" +
+	"shè 厍厙射弽慑慴懾摂摄摵攝欇歙涉涻渉滠灄社舎蔎蠂設设赦韘騇麝\n" +
+	"shèn 侺愼慎昚椹涁渗滲瘆瘮眘祳罧肾胂脤腎蜃蜄鋠\n" +
+	"shèng 剩剰勝圣墭嵊晠榺橳琞盛聖胜蕂貹賸\n" +
+	"shé 佘舌虵蛇蛥\n" +
+	"shéi 谁\n" +
+	"shén 什榊甚神鰰\n" +
+	"shéng 憴縄繩绳譝\n" +
+	"shì 世丗亊事仕似侍冟势勢卋叓呩嗜噬士奭媞嬕室崼市式弑弒徥忕恀恃戺拭揓是昰枾柹柿栻氏澨烒煶眂眎眡睗示礻筮簭舐舓螫襫視视觢試誓諟諡謚试谥豉貰贳軾轼适逝適遾釈释釋鈰鉃鉽銴铈飾餙餝饰鰘\n" +
+	"shí 乭十埘塒姼实実寔實峕嵵拾时旹時榯湜溡炻石祏竍莳蒔蚀蝕識识辻遈鉐食飠饣鮖鰣鲥鼫鼭\n" +
+	"shòu 兽受售壽夀寿授涭狩獣獸痩瘦綬绶膄鏉\n" +
+	"shù 侸咰墅尌庶庻怷恕戍捒数數朮术束树樹沭漱潄澍濖竖竪絉腧荗蒁虪術裋豎述鉥錰鏣隃鶐\n" +
+	"shùn 橓瞚瞬舜蕣順顺鬊\n" +
+	"shú 塾婌孰熟璹秫贖赎\n" +
+	"shā 乷刹剎唦杀桬榝樧殺毮沙煞猀痧砂硰粆紗纱莎蔱裟鎩铩魦鯊鯋鲨\n" +
+	"shāi 筛篩簁簛酾釃\n" +
+	"shān 删刪剼嘇圸埏姍姗山幓彡挻搧杉柵檆潸澘煽狦珊痁笘縿羴羶脠膻舢芟苫衫跚軕邖钐閊鯅\n" +
+	"shāng 伤傷商墒慯殇殤滳漡熵蔏螪觞觴謪鬺\n" +
+	"shāo 弰捎旓梢烧焼燒稍筲艄莦蕱蛸輎颵髾鮹\n" +
+	"shē 奢檨猞畬畲賒賖赊輋\n" +
+	"shēn 伸侁兟呻堔妽姺娠屾峷扟敒曑柛棽氠深燊珅甡甧申眒砷穼籶籸紳绅罙莘葠蓡蔘薓裑訷詵诜身駪鯓鯵鰺鲹鵢\n" +
+	"shēng 升呏声斘昇曻枡栍殅泩湦焺牲狌珄生甥竔笙聲苼鉎鍟阩陞陹鵿鼪\n" +
+	"shě 捨舍\n" +
+	"shěn 哂婶嬸审宷審弞曋沈渖瀋瞫矤矧覾訠諗讅谂谉邥頣魫\n" +
+	"shěng 偗渻省眚\n" +
+	"shī 呞失尸屍师師施浉湤湿溮溼濕狮獅瑡絁葹蒒蓍虱蝨褷襹詩诗邿釶鉇鉈鍦鯴鰤鲺鳲鳾鶳鸤\n" +
+	"shōu 収收\n" +
+	"shū 书倏倐儵叔姝尗抒掓摅攄書杸枢梳樞橾殊殳毹毺淑瀭焂瑹疎疏紓綀纾舒菽蔬跾踈軗輸输鄃陎鮛鵨\n" +
+	"shǎ 傻儍\n" +
+	"shǎi 繺\n" +
+	"shǎn 晱炶煔熌睒覢閃闪陕陝鿃\n" +
+	"shǎng 垧扄晌賞贘赏鑜\n" +
+	"shǎo 少\n" +
+	"shǐ 乨使兘史始宩屎榁矢笶豕鉂駛驶\n" +
+	"shǒu 垨守手艏首\n" +
+	"shǔ 属屬暏暑曙潻癙糬署薥薯藷蜀蠴襡襩鱪鱰鸀黍鼠鼡\n" +
+	"shǔn 吮\n" +
+	"suo 嗦\n" +
+	"suàn 祘笇筭算蒜\n" +
+	"suì 亗埣嬘岁嵗旞檖歲歳澻煫燧璲睟砕碎祟禭穂穗穟繀繐繸襚誶譢谇賥遂邃鐆鐩隧韢\n" +
+	"suí 瓍绥遀隋随隨\n" +
+	"suò 溹蜶逤\n" +
+	"suān 狻痠酸\n" +
+	"suī 倠哸夊浽滖濉熣眭睢綏芕荽荾葰虽雖鞖\n" +
+	"suō 傞唆嗍娑摍桫梭睃簑簔縮缩羧莏蓑趖髿鮻\n" +
+	"suǎn 匴\n" +
+	"suǐ 瀡膸髄髓\n" +
+	"suǒ 乺唢嗩惢所暛溑琐琑瑣璅索褨鎈鎍鎖鎻鏁锁\n" +
+	"sà 卅摋櫒泧脎萨薩虄鈒钑隡颯飒馺\n" +
+	"sài 僿嗮簺賽赛\n" +
+	"sàn 俕帴散閐\n" +
+	"sàng 丧喪\n" +
+	"sào 埽氉瘙矂髞\n" +
+	"sè 啬嗇懎擌栜歮歰洓涩渋澀澁濇濏瀒琗瑟璱瘷穑穡穯繬色譅轖銫鏼铯雭飋\n" +
+	"sì 亖佀価儩兕嗣四姒娰孠寺巳杫柶汜泗泤洍涘瀃牭祀禩竢笥耜肂肆蕼覗貄釲鈶鈻飤飼饲駟驷\n" +
+	"sòng 宋訟誦讼诵送鎹頌颂餸\n" +
+	"sòu 嗽瘶\n" +
+	"sù 傃僳嗉塐塑夙嫊宿愫愬憟梀榡樎樕橚殐泝洬涑溯溸潚潥玊珟璛碿簌粛粟素縤肃肅膆莤蔌藗觫訴謖诉谡趚蹜速遡遬鋉餗驌骕鱐鷫鹔\n" +
+	"sú 俗\n" +
+	"sā 仨挱挲撒\n" +
+	"sāi 嘥噻塞愢揌毢毸腮顋鰓鳃\n" +
+	"sān 三厁叁弎毵毶毿犙鬖\n" +
+	"sāng 桑桒槡\n" +
+	"sāo 慅掻搔溞繅缫臊螦騒騷骚鰠鱢鳋\n" +
+	"sē 閪\n" +
+	"sēn 森椮槮襂\n" +
+	"sēng 僧鬙\n" +
+	"sī 丝俬凘厮厶司咝嘶噝媤廝思恖撕斯楒榹泀澌燍磃禗禠私籭糹絲緦纟缌罳蕬虒蛳蜤螄蟖蟴鉰銯鋖鐁锶颸飔騦鷥鸶鼶\n" +
+	"sōng 倯凇娀崧嵩庺忪憽松枀枩柗梥檧淞濍硹菘蜙鍶鬆\n" +
+	"sōu 凁嗖廀廋捜搜摉摗溲獀艘蒐蓃螋鄋醙鎪锼颼颾飕餿馊騪\n" +
+	"sū 囌櫯甦稣穌窣苏蘇蘓酥鯂\n" +
+	"sūn 孙孫搎槂狲猻荪蓀蕵薞飧飱\n" +
+	"sǎ 洒潵灑訯躠靸\n" +
+	"sǎn 仐伞傘糁糂糝糣糤繖鏒鏾饊馓\n" +
+	"sǎng 嗓搡磉褬鎟顙颡\n" +
+	"sǎo 嫂扫掃\n" +
+	"sǐ 死\n" +
+	"sǒng 傱嵷怂悚愯慫楤竦耸聳駷\n" +
+	"sǒu 傁叜叟嗾擞擻櫢瞍籔薮藪\n" +
+	"sǔn 损損榫笋筍箰簨鎨隼鶽\n" +
+	"ta 侤咜\n" +
+	"tai 粏\n" +
+	"ti 笹\n" +
+	"tiao 螩\n" +
+	"tiàn 掭睼舚\n" +
+	"tiào 眺粜糶絩覜跳\n" +
+	"tián 塡填屇恬搷沺湉璳甛甜田畋畑畠盷碵磌窴緂胋菾鈿闐阗鴫鷆鷏鿬\n" +
+	"tiáo 岧岹条條樤祒笤芀萔蓚蓨蜩趒迢鋚鎥鞗髫鯈鰷鲦齠龆\n" +
+	"tiè 呫飻餮\n" +
+	"tiān 兲天婖添酟靔靝黇\n" +
+	"tiāo 佻庣恌挑旫祧聎\n" +
+	"tiē 帖怗聑萜貼贴\n" +
+	"tiě 僣蛈銕鋨鐡鐵铁驖鴩\n" +
+	"tiǎn 倎唺忝悿晪殄淟琠痶睓腆舔覥觍賟錪鍩靦餂\n" +
+	"tiǎo 嬥宨斢晀朓窕窱脁誂\n" +
+	"tu 汢\n" +
+	"tuàn 彖湪褖\n" +
+	"tuán 剸团団團慱抟摶槫檲漙篿糰鏄鷒鷻\n" +
+	"tuì 侻娧煺蛻蜕褪退駾\n" +
+	"tuí 尵弚穨蘈蹪隤頹頺頽颓魋\n" +
+	"tuò 唾柝毤毻箨籜萚蘀跅\n" +
+	"tuó 佗坨堶岮槖橐沱沲狏砣砤碢紽袉跎迱酡陀陁馱駄駝駞騨驒驮驼鮀鴕鸵鼉鼍鼧\n" +
+	"tuān 湍煓猯貒\n" +
+	"tuī 推蓷藬\n" +
+	"tuō 乇仛侂咃托扡拕拖挩捝杔汑沰涶脫脱莌袥託讬飥饦驝魠\n" +
+	"tuǎn 疃\n" +
+	"tuǐ 俀僓腿蹆骽\n" +
+	"tuǒ 妥媠嫷庹彵椭楕橢鬌鰖鵎\n" +
+	"tà 嚺崉拓挞搨撻榻橽毾涾澾濌狧禢誻譶踏蹋躢遝遢錔闒闥闼鞜鞳鮙\n" +
+	"tài 冭太夳忲态態汰泰溙燤肽舦酞鈦钛\n" +
+	"tàn 傝僋叹嘆埮探歎湠炭碳舕賧\n" +
+	"tàng 摥烫燙趟\n" +
+	"tào 套\n" +
+	"tá 蹹\n" +
+	"tái 儓台坮嬯抬擡旲枱檯炱炲箈籉臺苔菭薹跆邰颱駘鮐鲐\n" +
+	"tán 倓坛墰墵壇壜婒惔憛昙曇榃檀潭燂痰磹罈罎藫覃談譚譠谈谭貚郯醈醰錟锬顃餤\n" +
+	"táng 傏唐啺坣堂塘搪棠榶樘橖溏漟煻瑭磄禟篖糃糖糛膅膛蓎螗螳赯踼鄌醣鎕闛隚餳餹饄饧鶶\n" +
+	"táo 匋咷啕桃梼檮洮淘祹綯绹萄蜪裪迯逃醄鋾錭陶鞀鞉饀駣騊鼗\n" +
+	"tè 忑忒慝特螣蟘貣鋱铽\n" +
+	"tèng 霯\n" +
+	"téng 儯幐滕漛疼痋籐籘縢腾藤虅誊謄邆駦騰驣鰧\n" +
+	"tì 倜剃嚏嚔屉屜悌悐惕惖戻掦揥替朑楴歒殢洟涕瓋籊薙裼褅趯逖逷髰鬀\n" +
+	"tí 偍厗啼嗁崹徲惿提漽瑅碮禵稊綈緹绨缇罤苐荑蕛蝭褆謕趧蹄蹏遆醍銻鍗題题騠鮷鯷鳀鴺鵜鶗鶙鷤鹈\n" +
+	"tíng 亭停婷嵉庭廷楟榳渟筳聤莛葶蜓蝏諪邒閮霆鼮\n" +
+	"tòng 恸慟憅痛衕\n" +
+	"tòu 綉透\n" +
+	"tóng 仝佟僮勭同哃峂峝庝彤晍曈朣桐橦氃浵潼烔燑犝狪獞眮瞳砼秱童筩粡膧茼蚒詷赨酮鉖鉵銅铜餇鮦鲖\n" +
+	"tóu 亠头投緰頭骰\n" +
+	"tù 兎兔堍莵迌鵵\n" +
+	"tú 凃図图圕圖圗塗屠峹嵞庩廜徒悇捈揬梌涂潳瘏稌筡腯荼菟蒤跿途酴鈯鍎馟駼鵌鶟鷋鷵\n" +
+	"tún 坉屯忳臀臋芚豘豚軘霕飩饨魨鲀\n" +
+	"tā 他嚃塌她它榙溻牠祂褟趿铊闧\n" +
+	"tāi 囼孡胎\n" +
+	"tān 坍怹摊擹攤滩灘痑瘫癱舑貪贪\n" +
+	"tāng 劏嘡汤湯羰耥薚蝪蹚鏜鐋铴镗鞺鼞\n" +
+	"tāo 夲嫍幍弢慆掏搯槄涛滔濤瑫絛縚縧绦詜謟轁鞱韜韬飸饕\n" +
+	"tēng 熥膯鼟\n" +
+	"tī 剔擿梯踢锑鷈鷉\n" +
+	"tīng 厅厛听庁廰廳桯汀烃烴町綎耓聴聼聽艼鞓\n" +
+	"tōng 嗵囲樋炵痌蓪通\n" +
+	"tōu 偷偸婾媮鋀鍮\n" +
+	"tū 凸唋堗宊嶀怢捸涋湥痜禿秃突葖鋵鵚鼵\n" +
+	"tūn 吞呑啍噋旽暾朜涒焞黗\n" +
+	"tǎ 塔墖溚獭獺鰨鳎鿎\n" +
+	"tǎn 嗿坦忐憳憻暺毯璮菼袒襢醓鉭钽\n" +
+	"tǎng 伖倘偒傥儻帑戃曭淌爣矘躺鎲钂镋\n" +
+	"tǎo 討讨\n" +
+	"tǐ 体挮躰軆骵體鮧\n" +
+	"tǐng 侹圢娗挺梃涏烶珽甼脡艇誔頲颋\n" +
+	"tǒng 捅桶筒統綂统\n" +
+	"tǒu 妵敨紏蘣钭飳黈\n" +
+	"tǔ 吐土圡釷钍\n" +
+	"tǔn 氽畽\n" +
+	"wa 哇瓲\n" +
+	"wei 煀\n" +
+	"wen 呚\n" +
+	"wu 錻\n" +
+	"wà 嗢聉腽膃袜襪韈韤\n" +
+	"wài 外夞顡\n" +
+	"wàn 万卍卐妧忨捥杤澫瞣脕腕萬薍蟃贃贎輐鋄錽鎫\n" +
+	"wàng 妄忘旺望朢盳迋\n" +
+	"wá 娃\n" +
+	"wán 丸刓完岏抏捖汍烷玩琓笂紈纨翫芄貦頑顽\n" +
+	"wáng 亡亾仼兦彺王莣蚟\n" +
+	"wèi 为位卫叞味喂墛媦尉慰懀未渭為煟熭爲犚猬璏畏碨緭罻胃苿菋蔚藯蘶蜼蝟螱衛衞褽謂讆讏谓躗躛軎轊鏏霨餧餵饖魏鮇鳚\n" +
+	"wèn 問妏揾搵汶渂璺莬问顐\n" +
+	"wèng 瓮甕罋蕹齆\n" +
+	"wéi 唯喡囗围圍圩媁峗峞嵬帏帷幃惟桅欈沩洈涠湋溈潍潙潿濰犩琟癓硙磑維维蓶覹违違鄬醀鍏闈闱霺韋韦鮠\n" +
+	"wén 匁彣文炆玟珳瘒紋纹聞芠蚉蚊螡蟁閺閿闅闦闻阌雯馼駇魰鳼鴍鼤\n" +
+	"wò 仴偓卧媉幄捾握擭斡枂楃沃涴渥濣焥瓁瞃硪肟腛臒臥雘齷龌\n" +
+	"wù 伆兀务務勿卼坞塢奦婺寤屼岉嵍嵨忢悞悟悮戊扤敄晤杌溩焐熃物痦矹窹粅芴蘁誤误迕逜鋈阢隖雺雾霚霧靰騖骛鶩鹜鼿齀\n" +
+	"wú 吳吴吾呉唔娪无梧毋洖浯無珸璑祦禑芜茣莁蕪蜈蟱譕郚铻鯃鵐鷡鹀鼯\n" +
+	"wā 劸嗗娲媧屲挖搲攨洼溛漥畖穵窊窪蛙鼃\n" +
+	"wāi 喎歪竵\n" +
+	"wān 剜塆壪婠帵弯彎湾潫灣蜿豌\n" +
+	"wāng 尣尩尪尫汪\n" +
+	"wēi 偎危喴威媙嶶巍微愄揋揻椳楲渨溦烓煨燰縅萎葨葳薇蜲蝛覣詴逶隇隈鰃鰄鳂\n" +
+	"wēn 塭昷榅榲殟温溫瑥瘟蕰豱輼轀辒鎾鞰饂鰛鰮鳁\n" +
+	"wēng 嗡滃翁螉鎓鶲鹟\n" +
+	"wěi 伟伪偉偽僞儰厃壝委娓寪尾屗崣嵔徫愇捤撱斖暐梶椲洧浘濻瀢炜煒猥玮瑋痏痿硊磈緯纬腲艉芛苇荱葦蒍蔿薳諉诿踓鍡韑韙韡韪頠颹骩骪骫鮪鲔\n" +
+	"wěn 刎吻呡忟抆桽稳穏穩紊肳脗\n" +
+	"wěng 勜塕奣嵡攚暡瞈聬蓊\n" +
+	"wō 倭唩挝撾涡涹渦猧窝窩莴萵蜗蝸踒\n" +
+	"wū 乌剭呜嗚圬屋巫弙杇歍汙汚污洿烏窏箼螐誈誣诬邬鄔鎢钨鰞鴮\n" +
+	"wǎ 佤咓瓦砙邷\n" +
+	"wǎi 崴\n" +
+	"wǎn 倇唍埦婉宛惋挽晚晥晩晼梚椀琬畹皖盌睕碗綩綰绾脘菀萖踠輓鋔\n" +
+	"wǎng 往徃徍惘暀枉棢瀇網网罒罔菵蛧蝄誷輞辋魍\n" +
+	"wǒ 婐我捰\n" +
+	"wǔ 乄五仵伍侮俉倵儛午啎妩娬嫵庑廡忤怃憮捂摀旿橆武潕熓牾玝珷瑦甒碔舞躌鵡鹉\n" +
+	"xian 鑦\n" +
+	"xiao 恷\n" +
+	"xin 忄\n" +
+	"xing 哘裄\n" +
+	"xià 丅下乤吓嚇圷夏夓懗梺疜睱罅鎼鏬\n" +
+	"xiàn 伣僩僴县咞哯垷壏姭娊娨宪岘峴憲撊晛橌涀瀗献獻现現県睍硍粯糮絤綫線縣线缐羡羨腺臔臽苋莧蜆誢豏鋧錎限陥陷霰餡馅麲鼸\n" +
+	"xiàng 像勨向嚮塂姠嶑巷橡珦缿萫蟓衖襐象銗鐌項项鱌\n" +
+	"xiào 俲傚効咲啸嘋嘨嘯孝效敩斅斆校歗涍熽笑肖詨誟\n" +
+	"xiá 侠俠匣叚峡峽敮暇柙炠烚狎狭狹珨瑕硖硤碬磍祫筪縀縖翈舝舺蕸赮轄辖遐鍜鎋陜陿霞騢魻鶷黠\n" +
+	"xián 伭咸唌啣妶娴娹婱嫌嫺嫻弦憪挦撏涎湺澖甉痫癇癎瞯礥稴絃胘舷藖蚿蛝衔衘誸諴賢贒贤輱醎銜閑閒闲鷳鷴鷼鹇鹹麙\n" +
+	"xiáng 佭庠栙瓨祥絴翔詳详跭\n" +
+	"xiáo 崤殽洨淆筊訤誵郩\n" +
+	"xiè 亵伳偞偰僁卨卸噧塮夑娎媟屑屓屟屧嶰廨徢懈暬械榍榭泄泻洩渫澥瀉瀣灺炧炨烲焎燮爕獬祄禼糏紲絏絬緤繲绁缷薢薤蟹蠏褉褻謝谢躞邂鞢韰齂齘齛齥\n" +
+	"xié 偕劦勰协協嗋垥奊峫恊愶拹挟挾携撷擕擷攜斜旪熁燲瑎綊緳纈缬翓胁脅脇脋膎蝢衺襭諧讗谐邪鞋鞵頡龤\n" +
+	"xiòng 夐敻焸詗诇\n" +
+	"xióng 熊雄\n" +
+	"xiù 嗅岫峀溴珛琇璓秀繍繡绣螑袖褎褏銹鏥鏽锈齅\n" +
+	"xiú 苬\n" +
+	"xiā 傄煆疨瞎虲虾蝦谺閕颬鰕\n" +
+	"xiān 仙仚佡僊僲先嘕奾嬐屳廯忺憸掀攕暹杴枮氙珗祆秈籼繊纎纖纤苮莶薟褼襳跹蹮躚酰銛鍁铦锨韯韱馦鮮鱻鲜鶱\n" +
+	"xiāng 乡厢啌廂忀楿欀湘瓖相稥箱緗缃膷芗葙薌襄郷鄉鄊鄕鑲镶香驤骧鱜麘\n" +
+	"xiāo 侾呺哓哮嘐嘵嚣嚻囂婋宯宵庨彇憢揱枭枵梟櫹歊毊消潇瀟灱灲焇猇獢痚痟硝硣穘窙箫簘簫綃绡翛膮萧萷蕭藃虈虓蟂蟏蟰蠨踃逍銷销霄驍骁髇髐魈鴞鴵鷍鸮\n" +
+	"xiē 些揳楔歇猲蝎蠍\n" +
+	"xiě 写冩寫藛\n" +
+	"xiōng 兄兇凶匂匈哅忷恟汹洶胷胸訩詾讻賯\n" +
+	"xiū 休俢修咻庥樇烋烌羞脙脩臹貅銝鎀鏅飍饈馐髤髹鮴鱃鵂鸺\n" +
+	"xiǎ 閜\n" +
+	"xiǎn 冼尟尠崄嶮幰搟攇显櫶毨灦烍燹狝猃獫獮玁禒筅箲藓蘚蚬譣赻跣銑鍌险険險韅顕顯\n" +
+	"xiǎng 享亯响想晑曏蚃蠁銄響飨餉饗饟饷鮝鯗鱶鲞\n" +
+	"xiǎo 小晓暁曉皛皢筱筿篠謏\n" +
+	"xiǒng 焽\n" +
+	"xiǔ 朽滫潃糔綇\n" +
+	"xu 蓿\n" +
+	"xuàn 怰昡楥楦泫渲炫琄眩眴碹絢縼繏绚蔙衒袨讂贙鉉鏇铉镟鞙颴\n" +
+	"xuán 嫙悬懸旋暶檈漩玄玹琁璇璿痃蜁\n" +
+	"xuè 吷坹桖瀥狘血謔谑趐\n" +
+	"xué 乴壆学學岤峃嶨斈泶澩燢穴茓袕觷踅雤鷽鸴\n" +
+	"xuān 儇吅喧塇媗宣弲愃愋懁揎昍暄梋煊瑄睻矎禤箮縇翧翾萱萲蓒蕿藼蘐蝖蠉諠諼譞谖軒轩鋗鍹駽鰚\n" +
+	"xuē 削疶蒆薛辥辪靴鞾\n" +
+	"xuě 樰膤艝轌雪鱈鳕\n" +
+	"xuǎn 咺晅烜癣癬选選顈\n" +
+	"xì 係匸卌呬咥嚱墍屃屭忥怬恄慀戏戱戲椞欯滊潟澙熂犔盻矽磶禊稧系細綌繫细绤舃舄蕮虩衋覤赩趇郤釳闟阋隙隟霼餼饩鬩黖\n" +
+	"xìn 伩信囟孞焮脪舋衅訫軐釁阠顖馸\n" +
+	"xìng 倖兴姓婞嬹幸性悻杏涬緈臖興荇莕\n" +
+	"xí 习喺媳嶍席椺槢檄漝習蒵蓆薂袭襲覡觋謵趘郋鎴隰霫飁騱騽驨鰼鳛\n" +
+	"xín 枔襑鐔\n" +
+	"xíng 侀刑型娙形洐滎硎荥行邢郉鈃鉶銒鋞钘铏陉陘\n" +
+	"xù 伵侐勖勗卹叙喣垿壻婿序怴恤慉敍敘旭昫朂槒欰殈汿沀洫溆漵潊烅烼煦獝珬盢瞁瞲稸絮続緒緖續绪续聓聟芧蓄藇藚訹賉酗銊魣鱮\n" +
+	"xùn 伨侚卂噀奞巺巽徇愻殉殾汛潠狥稄蕈訊訓訙训讯賐迅迿逊遜鑂顨\n" +
+	"xú 俆徐蒣\n" +
+	"xún 偱噚寻尋峋巡廵循恂揗攳旬杊栒桪樳毥洵浔潯灥燅燖珣璕畃紃荀荨蟳詢询鄩馴驯鱏鱘鲟\n" +
+	"xī 俙傒僖兮凞卥厀吸唏唽嘻噏夕奚嬆嬉屖嵠嶲巇希徆徯忚怸恓息悉悕惁惜憙扱扸昔晞晰晳曦析桸榽樨橀欷氥汐浠淅渓溪潝烯焁焈焟焬煕熄熈熙熹熺熻燨爔牺犀犠犧狶琋瘜皙睎瞦硒磎礂稀穸窸粞糦緆縘繥羲翕翖肸肹膝舾莃菥蒠蜥螅螇蟋蠵西覀觹觽觿譆谿豀豨豯貕赥邜郗鄎酅醯釐釸錫鏭鑴锡隵雟餏饻鯑鵗鸂鼷\n" +
+	"xīn 俽噺妡嬜廞心忻惞新昕杺欣歆炘盺芯薪訢辛邤鈊鋅鑫锌馨馫\n" +
+	"xīng 垶惺星曐煋猩瑆皨箵篂腥蛵觪觲謃騂骍鮏鯹\n" +
+	"xū 吁嘘噓墟媭嬃幁戌揟旴晇楈欨歔湑疞盱窢縃繻胥蕦虗虚虛蝑裇訏諝譃谞鑐需須頊须顼驉鬚魆魖\n" +
+	"xūn 勋勛勲勳嚑坃埙塤壎壦曛焄熏燻爋獯矄窨纁臐蔒薫薰蘍醺駨\n" +
+	"xǐ 喜囍壐屣徙憘暿枲橲歖洗漇玺璽矖禧縰葈葸蓰蟢諰謑蹝躧鈢鉨鉩铣鱚\n" +
+	"xǐn 伈\n" +
+	"xǐng 擤睲醒\n" +
+	"xǔ 偦冔呴姁暊栩珝盨稰糈許詡许诩鄦醑\n" +
+	"ya 乛呀\n" +
+	"yang 羪\n" +
+	"ye 亪\n" +
+	"yin 粌\n" +
+	"you 蒏\n" +
+	"yu 澚\n" +
+	"yun 抣繧\n" +
+	"yuàn 傆噮垸夗妴媛怨愿掾瑗禐肙苑衏裫褑褤院願\n" +
+	"yuán 元円原厡厵员員园圆圎園圓垣塬媴嫄援杬榞榬橼櫞沅湲源溒爰猨猿獂笎緣縁缘羱茒蒝薗蚖蝝蝯螈袁謜貟贠轅辕邍邧酛鈨鎱騵魭鶢鶰黿鼋\n" +
+	"yuè 刖妜嬳岄岳嶽恱悅悦戉抈捳月樾瀹爚玥礿禴篗籆籥籰粤粵蘥蚎蚏越跀跃躍軏鈅鉞钺閱閲阅鸑鸙黦龠\n" +
+	"yuān 冤剈囦嬽寃悁惌棩淵渁渆渊渕灁眢箢葾蒬蜎蜵裷駌鳶鴛鵷鸢鸳鹓鼘鼝\n" +
+	"yuē 彟彠曰曱矱箹約约\n" +
+	"yuǎn 盶远逺遠鋺\n" +
+	"yà 亚亜亞俹劜圔圠娅婭挜掗揠氩氬犽猰砑稏窫聐襾訝讶軋轧迓齾\n" +
+	"yàn 偐傿厌厭咽唁喭嚥堰墕妟姲嬊嬿宴彥彦敥晏暥曕曣椻溎滟灎灔灧灩烻焔焰焱熖燄燕爓牪猒砚硯艳艶艷葕覎觃觾諺讌讞谚谳豓豔贋贗赝軅酀酽醶醼釅隁雁餍饜騐験騴驗驠验鬳鳫鴈鴳鷃鷰\n" +
+	"yàng 怏恙样様樣漾瀁羕詇\n" +
+	"yào 曜熎燿獟矅穾窔筄纅耀艞药葯薬藥袎要覞詏讑鑰钥靿鷂鹞鼼\n" +
+	"yá 伢厑厓堐岈崕崖涯漄牙猚玡琊瑘睚笌芽蚜衙齖\n" +
+	"yán 严厳啱嚴塩壛壧妍姸娫娮孍岩嵒嵓巌巖巗延揅昖楌檐櫩欕沿炎狿琂盐研硏碞礹筵簷綖芫莚蔅虤蜒言訁訮詽讠郔閆閻闫阎顏顔颜鹽麣黬\n" +
+	"yáng 佯劷垟崵崸徉扬揚敭旸昜暘杨楊氜洋炀烊煬珜疡瘍眻禓羊羏蛘諹輰鍚鐊钖阦阳陽霷颺飏鰑鴹鸉\n" +
+	"yáo 倄傜嗂垚堯姚媱尧尭峣嶢嶤徭愮揺搖摇摿暚榣滧烑爻猺珧瑤瑶磘窑窯窰繇肴蘨謠謡谣軺轺遙遥邎銚鎐顤颻飖餆餚鰩鳐\n" +
+	"yè 业亱僷叶啘嚈堨墷夜嶪嶫抴捙擛擪擫晔曄曅曗曳曵枼枽楪業歋殗洂液澲烨燁爗璍皣瞱瞸礏腋葉謁谒邺鄓鄴鍱鎑鐷靥靨頁页餣饁馌驜鵺鸈\n" +
+	"yé 捓揶擨爷爺耶釾鋣鎁铘\n" +
+	"yì 乂义亄亦亿伇伿佚佾俋億兿刈劓劮勚勩匇呓呭呹唈囈圛坄垼埶埸墿奕嫕嬑嬟寱屹峄嶧帟帠幆廙异弈弋役忆怈怿悒悥意憶懌懿抑挹掜撎敡斁易晹曀曎杙枍枻栧栺棭榏槸檍欥欭歝殔殪殹毅泆浂浥浳湙溢潩澺瀷炈焲熠熤熼燚燡燱獈玴異疫痬瘗瘞瘱癔益睪瞖硛秇穓竩縊繶繹绎缢羛義羿翊翌翳翼耴肄肊膉臆艗艺芅苅萟蓺薏藙藝蘙虉蛡蜴螠衵袣裔裛褹襼訲訳詍詣誼譯議讛议译诣谊豙豛豷貖賹贀跇軼轶逸邑醳醷釴鈠鎰鐿镒镱陭隿霬靾饐駅驛驿骮鮨鯣鶂鶃鶍鷁鷊鷧鷾鹝鹢黓齸\n" +
+	"yìn 印垽堷廕慭憖憗懚檼洕湚猌癊胤茚酳鮣\n" +
+	"yìng 噟媵映暎硬膡鞕鱦\n" +
+	"yí 乁仪侇儀冝匜咦圯夷姨媐宐宜宧寲峓嶬嶷巸弬彛彜彝彞怡恞扅拸暆柂栘桋椬椸沂沶熪狋珆瓵疑痍眙移箷簃籎羠耛胰萓蛦螔衪袘觺訑詑詒誃謻讉诒貤貽贻跠迆迤迻遗遺鏔頉頤頥顊颐飴饴鸃\n" +
+	"yín 乑冘吟噖嚚圁垠夤婬寅峾崟崯斦檭殥泿淫滛烎犾狺珢璌碒苂荶蔩蟫訔訚訡誾鄞鈝銀银霪鷣齗龂\n" +
+	"yíng 僌営塋嬴攍楹櫿溁溋滢潆濙濚濴瀅瀛瀠瀯瀴灐灜熒營瑩盁盈籝籯縈茔荧莹萤营萦萾蓥藀蛍蝇蝿螢蠅覮謍贏赢迎鎣\n" +
+	"yòng 用砽苚醟\n" +
+	"yòu 亴佑侑又右哊唀囿姷孧宥峟幼柚牰狖祐糿蚴誘诱貁迶酭釉鼬\n" +
+	"yóng 喁揘顒颙鰫\n" +
+	"yóu 偤尢尤峳怣斿楢櫾沋油浟游犹猶猷由疣秞肬莜莸蕕蚰蝣訧輏輶逰遊邮郵鈾铀駀魷鮋鱿鲉\n" +
+	"yù 俼儥喅喐喩喻噊圫域堉妪媀嫗寓峪嶎庽彧御忬悆惐愈慾戫昱棛棜棫櫲欎欝欲毓浴淢淯滪潏澦灪焴煜燏燠爩狱獄玉琙瘉癒矞砡硲礇礖礜禦秗稢稶穥篽籞籲緎繘罭聿肀育艈芋芌茟蒮蓣蓹蕷薁蜟蜮袬裕誉諭譽谕豫軉輍轝逳遇遹郁醧鈺銉鋊錥鐭钰閾阈霱預预飫饇饫馭驈驭鬰鬱鬻魊鱊鳿鴥鴧鴪鵒鷸鸒鹆鹬龥\n" +
+	"yùn 傊孕恽惲愠慍枟熅熨緷緼縕腪蕴薀藴蘊运運郓鄆酝醖醞韗韞韫韵韻餫\n" +
+	"yú 乻于亐伃余俞兪堣堬妤娛娯娱嬩崳嵎嵛愉愚扵揄於旕旟杅桙楡楰榆欤歈歟歶渔渝湡漁澞牏狳玗玙瑜璵畭盂睮硢禺窬竽籅羭腴臾舁舆艅茰萮萸蕍蘛虞蝓螸衧褕覦觎諛謣谀踰輿逾邘酑鍝隅雓雩餘馀騟骬髃魚鮽鯲鰅鱼鷠鸆\n" +
+	"yún 云伝勻匀囩妘愪昀橒沄涢溳澐熉畇眃秐筠筼篔紜縜纭耘耺芸蒷蕓郧鄖鋆雲\n" +
+	"yā 丫压吖圧垭埡壓孲庘押枒桠椏錏鐚铔鴉鴨鵶鸦鸭\n" +
+	"yān 偣剦嫣嬮崦嶖恹懕懨樮淊淹湮漹烟焉焑煙珚硽篶胭腌臙菸鄢醃閹阉黫\n" +
+	"yāng 咉央姎抰殃泱眏秧胦鉠雵鞅鴦鸯\n" +
+	"yāo 吆喓夭妖幺枖楆殀祅腰葽訞邀鴁\n" +
+	"yē 倻噎掖暍椰潱蠮\n" +
+	"yě 也冶吔嘢埜壄漜野\n" +
+	"yī 一乊伊依医吚咿噫壱壹夁嫛嬄弌悘揖檹欹毉洢渏漪猗瑿畩祎禕稦繄蛜衣衤譩辷郼醫銥铱鷖鹥黟黳\n" +
+	"yīn 侌凐喑噾囙因垔堙姻婣愔慇栶歅殷氤洇溵瘖禋秵筃絪緸茵荫蒑蔭裀諲銦铟闉阥阴陰陻隂霒霠鞇音韾駰骃\n" +
+	"yīng 偀啨嘤嚶婴媖嫈嬰孆孾应応愥應撄攖朠桜樱櫻渶煐珱瑛璎瓔甇甖碤礯緓纓绬缨罂罃罌膺英莺蘡蝧蠳褮譍譻賏軈鍈鑍锳霙韺鴬鶑鶧鶯鷪鷹鸎鸚鹦鹰\n" +
+	"yō 哟唷喲\n" +
+	"yōng 佣傭嗈噰墉壅嫞庸廱慵拥擁槦滽澭灉牅痈癕癰臃邕郺鄘鏞镛雍雝饔鱅鳙鷛\n" +
+	"yōu 优優呦嚘幽忧怮悠憂攸櫌泑滺瀀纋耰逌鄾麀\n" +
+	"yū 唹扜淤瘀盓穻箊紆纡虶込迂迃陓\n" +
+	"yūn 奫晕暈氲氳煴缊蒀蒕蝹贇赟頵馧\n" +
+	"yǎ 厊哑唖啞庌痖瘂蕥雅\n" +
+	"yǎn 乵俨偃儼兖兗匽厣厴噞夵奄嵃巘巚弇愝戭扊抁掩揜曮棪椼檿沇渰渷演琰甗眼縯罨萒蝘衍裺褗躽遃郾酓隒顩魇魘鰋鶠黡黤黭黶鼴鼹齞齴龑\n" +
+	"yǎng 仰佒傟养坱岟慃懩攁柍楧氧氱炴痒癢礢紻蝆軮養駚\n" +
+	"yǎo 仸偠咬婹宎岆崾抭杳柼榚溔狕眑窅窈舀苭蓔闄騕鴢鷕齩\n" +
+	"yǐ 乙以佁倚偯崺已庡扆攺敼旑旖椅檥矣礒笖舣艤苡苢蚁螘蟻裿踦輢轙逘酏釔鈘鉯钇顗鳦齮\n" +
+	"yǐn 乚吲尹嶾廴引朄檃櫽淾濥濦瘾癮磤蘟蚓螾讔赺趛輑鈏隐隠隱靷飮飲饮\n" +
+	"yǐng 巊廮影摬梬浧潁瘿癭矨穎郢鐛頴颍颕颖\n" +
+	"yǒng 俑傛勇勈咏埇塎嵱彮怺恿悀惥愑愹慂柡栐永泳涌湧甬硧禜蛹詠踊踴鯒鲬\n" +
+	"yǒu 丣卣友庮懮有栯梄槱湵牖牗禉羐羑聈脜苃莠蜏酉銪铕黝\n" +
+	"yǔ 与予伛俁俣偊傴匬噳圄圉宇寙屿峿嶼庾懙挧敔斔斞楀瑀瘐祤禹窳羽與萭蘌語语貐鄅鋙雨頨麌齬龉\n" +
+	"yǔn 允喗夽抎殒殞狁磒荺褞賱鈗阭陨隕霣馻齫齳\n" +
+	"ze 伬\n" +
+	"zen 囎\n" +
+	"zhang 鏱\n" +
+	"zhao 罀\n" +
+	"zhe 着著\n" +
+	"zhi 徔\n" +
+	"zhuo 窧\n" +
+	"zhuàn 僎啭囀堟撰灷瑑篆篹籑腞蒃襈譔賺赚饌馔\n" +
+	"zhuàng 壮壯壵戇撞漴焋状狀\n" +
+	"zhuì 坠墜娷惴桘甀畷硾礈笍綴縋缀缒膇諈贅赘轛醊錣鑆餟\n" +
+	"zhuó 丵劅叕啄啅圴妰娺彴撯擆擢斀斫斱斲斵晫梲椓櫡汋浊浞濁濯灂灼烵犳琸硺禚窡篧籗籱罬茁蠗諁諑謶诼酌鋜鐯鐲镯鵫鷟\n" +
+	"zhuā 抓檛簻膼髽\n" +
+	"zhuāi 拽\n" +
+	"zhuān 专叀塼嫥専專瑼甎砖磗磚膞蟤諯鄟顓颛鱄\n" +
+	"zhuāng 妆妝娤庄庒桩梉樁湷粧糚荘莊装裝\n" +
+	"zhuī 追錐锥隹騅骓鵻\n" +
+	"zhuō 倬卓拙捉桌棁棳槕涿炪穛穱蠿\n" +
+	"zhuǎi 跩\n" +
+	"zhuǎn 孨竱転轉转\n" +
+	"zhuǐ 沝\n" +
+	"zhà 乍咤宱搾柞栅榨溠灹炸痄蚱詐诈醡霅\n" +
+	"zhài 债債寨瘵砦\n" +
+	"zhàn 佔偡占嶘战戦戰栈桟棧湛站綻绽菚蘸虥虦覱譧輚轏驏\n" +
+	"zhàng 丈仗墇嶂帐帳幛扙杖涱痮瘬瘴瞕粀胀脹賬账障\n" +
+	"zhào 兆召垗旐曌枛棹櫂炤照燳狣瞾笊罩羄肁肇肈詔诏赵趙鮡\n" +
+	"zhá 札煠牐甴箚耫蚻譗鍘铡閘闸\n" +
+	"zhái 宅檡\n" +
+	"zhè 柘樜浙淛潪蔗蟅这這鷓鹧\n" +
+	"zhèn 侲圳塦挋振揕敶朕栚瑱甽眹紖絼纼誫賑赈酖鋴鎭鎮镇阵陣震鴆鸩\n" +
+	"zhèng 塣帧幀政正症証諍證证郑鄭鴊\n" +
+	"zhé 厇哲啠喆嚞埑悊折摺晢晣歽矺砓磔籷粍虴蛰蟄袩詟謫謺讁讋谪輒輙轍辄辙銸馲鮿\n" +
+	"zhì 乿俧偫傂儨制劕厔垁墆娡寘峙崻帙帜幟庢庤廌彘徏徝志忮憄懥懫扻挃挚掷搱摯擲擳旘晊智柣栉桎梽楖櫍櫛治洷滍滞滯潌瀄炙熫狾猘瓆畤疐痔痣礩祑秩秲秷稚稺穉窒筫紩緻置翐膣至致芖蛭螲袟袠製覟觗觯觶誌豑豒豸貭質贄质贽跱踬躓軽輊轾迣郅銍鋕鑕铚锧阤陟隲雉駤騭騺驇骘鯯鴙鷙鸷鿵\n" +
+	"zhí 侄値值嗭埴執墌妷姪嬂慹执摭植樴殖淔漐犆瓡直禃絷縶聀职職膱蟙跖踯蹠躑軄釞鉄馽\n" +
+	"zhòng 仲众偅堹妕媑狆眾祌筗茽蚛衆衶諥重\n" +
+	"zhòu 伷僽冑呪咒咮噣宙昼晝甃皱皺籀籒籕粙紂縐纣绉胄荮葤詋詶酎駎驟骤\n" +
+	"zhóu 妯軸轴\n" +
+	"zhù 伫佇住助坾墸壴嵀杼柱樦殶注炷疰眝砫祝祩竚筑筯箸篫紵紸纻羜翥苎莇蛀註貯贮跓軴迬鉒鋳鑄铸霔馵駐驻麆\n" +
+	"zhùn 稕訰\n" +
+	"zhú 孎曯欘泏灟炢烛燭爥瘃窋竹竺笁笜築舳茿蠋蠾躅逐钃鱁\n" +
+	"zhā 偧劄吒哳喳奓扎抯挓揸摣柤査楂樝渣皶皻觰譇齄齇\n" +
+	"zhāi 夈捚摘斋斎榸粂齋\n" +
+	"zhān 噡嶦惉旃旜枬栴毡氈氊沾瞻粘薝蛅詀詹譫讝谵趈邅閚霑飦饘驙魙鱣鳣鸇鹯\n" +
+	"zhāng 傽嫜张張彰慞暲樟漳獐璋章粻蔁蟑遧鄣餦騿鱆麞\n" +
+	"zhāo 佋啁妱巶招昭皽盄窼釗鉊鍣钊駋\n" +
+	"zhē 嗻嫬蜇遮\n" +
+	"zhēn 侦偵嫃寊帪搸斟栕桢桭楨榛樼殝浈潧澵獉珍珎瑧甄眞真砧碪祯禎禛箴籈胗臻葴蒖蓁薽貞贞轃遉酙針鉁錱鍼针靕鱵\n" +
+	"zhēng 争佂凧埩姃媜峥崝崢征徰徴怔挣掙揁炡烝爭狰猙癥眐睁睜筝箏篜聇蒸诤踭鉦錚钲铮鬇鯖\n" +
+	"zhě 乽啫禇者褶襵赭锗\n" +
+	"zhěn 屒弫抮昣枕畛疹眕稹紾縥缜聄萙袗裖診诊軫轸駗鬒黰\n" +
+	"zhěng 愸抍拯掟撜整晸氶糽\n" +
+	"zhī 之倁卮吱坧巵戠搘支枝栀梔椥榰汁汥泜疷知祗祬禔秓秖秪稙綕織织肢胑胝脂臸芝蘵蜘衼隻馶鳷鴲鼅\n" +
+	"zhōng 中伀刣妐幒彸忠柊汷泈炂盅籦終终舯蔠螤螽衳衷蹱鈡銿鍾鐘钟锺鴤鼨\n" +
+	"zhōu 侜周喌州徟掫洲淍炿烐珘盩矪粥舟謅譸诌诪賙赒輈輖辀週郮銂霌駲騆鵃鸼\n" +
+	"zhū 侏劯朱株槠橥櫧櫫洙潴瀦猪珠硃秼絑茱蛛蝫蠩袾誅諸诛诸豬跦邾銖铢駯鮢鯺鴸鼄\n" +
+	"zhūn 宒窀肫衠諄谆迍\n" +
+	"zhǎ 厏拃搩眨砟苲踷鮓鮺鲊鲝\n" +
+	"zhǎi 窄鉙\n" +
+	"zhǎn 嫸展崭嶃嶄搌斩斬榐橏琖盏盞輾醆颭飐黵\n" +
+	"zhǎng 仉幥掌涨漲礃長长\n" +
+	"zhǎo 找沼爪爫瑵\n" +
+	"zhǐ 凪劧只咫址坁夂帋徵怾恉扺抧指旨枳止汦沚洔淽疻砋祉紙纸芷茋藢衹襧訨趾軹轵酯阯黹\n" +
+	"zhǒng 冢喠塚塜尰歱煄瘇种種穜肿腫踵\n" +
+	"zhǒu 帚晭疛睭箒肘菷鯞\n" +
+	"zhǔ 丶主劚嘱囑宔拄斸渚濐煑煮瞩矚罜詝陼麈\n" +
+	"zhǔn 准凖埻準綧\n" +
+	"zi 子\n" +
+	"zong 潈\n" +
+	"zui 枠穝\n" +
+	"zuo 咗\n" +
+	"zuàn 攥鑚\n" +
+	"zuì 晬最栬槜檇檌祽稡絊罪蕞辠酔酻醉鋷錊\n" +
+	"zuò 作侳做唑坐岝岞座怍祚糳胙葃葄蓙袏阼飵\n" +
+	"zuó 捽昨椊琢秨稓筰莋鈼\n" +
+	"zuān 躜鑽钻\n" +
+	"zuī 厜嗺朘樶纗蟕\n" +
+	"zuǎn 籫繤纂纉纘缵\n" +
+	"zuǐ 嘴噿嶊嶵璻\n" +
+	"zuǒ 佐左繓\n" +
+	"zài 傤儎再在扗洅縡載载酨\n" +
+	"zàn 暂暫濽灒瓉瓒瓚禶襸讃讚賛贊赞蹔鄼酇錾鏨饡\n" +
+	"zàng 塟奘弉脏臓臟葬銺\n" +
+	"zào 唕唣喿噪慥梍灶煰燥皁皂竃竈簉艁譟趮躁造\n" +
+	"zá 偺喒囋囐杂沯砸磼襍雑雜雥韴\n" +
+	"zán 咱\n" +
+	"záo 凿鑿\n" +
+	"zè 仄夨崱庂捑昃昗汄\n" +
+	"zèn 譖譛谮\n" +
+	"zèng 甑贈赠鋥锃\n" +
+	"zé 则則唶啧嘖嫧帻幘択择擇樍歵沢泎泽溭澤皟瞔矠礋笮箦簀舴蔶蠌襗諎謮責賾责赜迮鸅齚齰\n" +
+	"zéi 戝蠈賊贼鯽鰂鱡鲗\n" +
+	"zì 倳剚字恣渍漬牸眥眦胔胾自芓茡荢\n" +
+	"zí 蓻\n" +
+	"zòng 倊昮猔疭瘲碂粽糉糭縦縱纵錝\n" +
+	"zòu 奏揍楱\n" +
+	"zùn 捘銌\n" +
+	"zú 傶卆卒哫崒崪族箤足踤踿鏃镞\n" +
+	"zā 匝咂帀拶沞紥紮臜臢迊鉔魳\n" +
+	"zāi 哉栽渽溨災灾烖甾睵菑賳\n" +
+	"zān 兂簪簮糌鐕鐟\n" +
+	"zāng 匨牂羘臧蔵賍賘贓贜赃髒\n" +
+	"zāo 傮糟蹧遭醩\n" +
+	"zēng 増增憎橧熷璔矰磳繒缯罾譄鄫鱛\n" +
+	"zěn 怎\n" +
+	"zī 乲兹咨嗞姕姿孜孳孶崰嵫栥椔淄湽滋澬玆璾禌秶稵粢紎緇缁茊茲葘觜訾諮谘貲資赀资赼趑趦輜輺辎鄑鈭錙鍿鎡锱镃頾頿髭鯔鰦鲻鶅鼒齍龇\n" +
+	"zōng 倧堫宗嵏嵕嵸惾朡棕椶熧猣磫稯綜緃緵综翪腙葼蝬豵踨踪蹤鍐鑁騌騣骔鬃鬉鬷鯮鯼\n" +
+	"zōu 棷棸箃緅菆諏诹邹郰鄒鄹陬騶驺鯫鲰黀齱齺\n" +
+	"zū 租葅蒩\n" +
+	"zūn 墫壿尊嶟樽繜罇遵鐏鱒鳟鶎鷷\n" +
+	"zǎ 咋\n" +
+	"zǎi 宰崽\n" +
+	"zǎn 儧儹噆寁揝撍攅攒攢昝桚趱趲\n" +
+	"zǎng 駔驵\n" +
+	"zǎo 早枣栆棗澡璪繰薻藻蚤\n" +
+	"zǐ 仔吇呰啙姉姊杍梓榟橴滓矷秄秭笫籽紫耔胏虸訿釨\n" +
+	"zǒng 偬傯总惣愡捴揔搃摠燪総縂總蓗鏓\n" +
+	"zǒu 走赱鯐\n" +
+	"zǔ 俎唨爼珇祖組组詛诅鎺阻靻\n" +
+	"zǔn 僔噂撙譐\n" +
+	"ài 伌僾叆嗌塧壒嫒嬡愛懓懝暧曖爱瑷璦皧瞹砹硋碍礙艾薆譺鑀閡隘靉餲馤鱫鴱\n" +
+	"àn 堓婩岸按晻暗案洝犴胺荌豻貋錌闇鮟黯鿷\n" +
+	"àng 枊盎醠\n" +
+	"ào 傲坳垇墺奡奥奧嫯岙岰嶴慠懊扷擙澳鏊隩驁骜鿫\n" +
+	"á 嗄\n" +
+	"ái 凒啀嘊捱敱敳溰癌皑皚騃\n" +
+	"án 儑啽玵雸\n" +
+	"áng 卬岇昂昻\n" +
+	"áo 厫嗷嗸嶅廒摮敖滶熬獒獓璈磝翱翶翺聱蔜螯謷謸遨鏖隞鰲鳌鷔鼇\n" +
+	"è 偔僫匎卾厄呃呝咢咹噩垩堊堮姶屵岋峉崿廅恶悪惡愕戹扼搤搹擜櫮歞歺湂琧砐砨硆礘腭苊萼蕚蚅蝁覨詻諤讍谔豟軛軶轭遌遏遻鄂鈪鍔鑩锷閼阏阨阸頞顎颚餓餩饿魥鰐鱷鳄鶚鹗齃齶\n" +
+	"èn 摁\n" +
+	"èr 二佴刵咡弍弐樲衈誀貮貳贰鉺\n" +
+	"é 俄吪囮娥峨峩涐珴皒睋磀莪蛾訛誐譌讹迗鈋锇頟額额魤鰪鵝鵞鹅\n" +
+	"éi 誒诶\n" +
+	"ér 侕儿児兒唲峏栭洏粫而聏胹荋袻輀轜陑隭髵鮞鲕鴯鸸\n" +
+	"òu 怄慪\n" +
+	"ó 哦\n" +
+	"óu 齵\n" +
+	"ā 锕阿\n" +
+	"āi 哀哎唉嗳噯埃娭挨欸溾銰鎄锿\n" +
+	"ān 侒媕安峖庵桉氨痷盦盫腤菴萻葊蓭誝諳谙鞌鞍韽馣鵪鶕鹌\n" +
+	"āng 肮骯\n" +
+	"āo 凹柪梎爊軪\n" +
+	"ē 妸妿娿婀屙痾\n" +
+	"ēn 奀恩煾蒽\n" +
+	"ēng 鞥\n" +
+	"ě 噁枙砈頋騀鵈\n" +
+	"ěn 峎\n" +
+	"ěr 厼尒尓尔栮毦洱爾珥耳薾趰迩邇铒餌饵駬\n" +
+	"ń 嗯\n" +
+	"ō 喔噢\n" +
+	"ōu 塸櫙欧歐殴毆沤漚熰瓯甌筽膒藲謳讴鏂鴎鷗鸥\n" +
+	"ǎi 娾昹毐濭矮蔼藹譪躷霭靄\n" +
+	"ǎn 俺唵垵埯揞罯銨铵隌\n" +
+	"ǎo 媪媼抝拗芺袄襖镺\n" +
+	"ǒu 偶吘呕嘔耦腢蕅藕\n" +
+	"ḿ 呣\n"
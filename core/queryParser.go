@@ -0,0 +1,237 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+/*
+A QueryParser turns the text a user searches for into a Query, analyzing it with the analyzer
+the fields were indexed with, so the query has the terms of the index.
+
+	bright moon           documents with either word, or both with OperatorAnd
+	title:moon            a word of another field than the default one
+	"bright moon"         the words as a phrase
+	title:"bright moon"   a phrase of another field
+
+The words of a field are analyzed together, so a filter sees them as the text it was given,
+a synonym filter finds the synonyms of several words, and the token graph it emits is turned
+into the paths through it: a stretch of the graph with several paths matches any of them,
+each path a term or a phrase, so 长安 in a query also finds 京城.
+A token stacked on the first one, of position increment 0, starts at position 0.
+*/
+
+// Operator how the words of a query are combined
+type Operator int
+
+const (
+	// OperatorOr a document matches some word
+	OperatorOr Operator = iota
+	// OperatorAnd a document matches every word
+	OperatorAnd
+)
+
+// QueryParser parser of query text
+type QueryParser struct {
+	DefaultField    string   // field of the words without a field
+	Analyzer        Analyzer // analyzer of the query text, that of the index
+	DefaultOperator Operator // how the words are combined
+}
+
+// queryClause words of a field in the query text
+type queryClause struct {
+	field  string
+	text   string
+	phrase bool
+}
+
+// graphToken token of a token graph, from position start to position end
+type graphToken struct {
+	text       string
+	start, end int64
+}
+
+// NewQueryParser parser of the words of a field
+func NewQueryParser(defaultField string, analyzer Analyzer) *QueryParser {
+	return &QueryParser{DefaultField: defaultField, Analyzer: analyzer}
+}
+
+// ================================QueryParser=======================================
+
+// Parse query of a query text, nil when the text has no term
+func (qp *QueryParser) Parse(text string) (Query, error) {
+	if qp.Analyzer == nil {
+		return nil, fmt.Errorf("no analyzer for the query parser")
+	}
+	clauses, err := qp.split(text)
+	if err != nil {
+		return nil, err
+	}
+
+	occur := OccurShould
+	if qp.DefaultOperator == OperatorAnd {
+		occur = OccurMust
+	}
+	queries := []Query{}
+	for _, c := range clauses {
+		tokens, err := TokenSlice(qp.Analyzer, c.field, c.text)
+		if err != nil {
+			return nil, err
+		}
+		graph := tokenGraph(tokens)
+		if len(graph) == 0 {
+			continue
+		}
+		if c.phrase {
+			queries = append(queries, graphQuery(c.field, graph))
+			continue
+		}
+		for _, part := range graphParts(graph) {
+			queries = append(queries, graphQuery(c.field, part))
+		}
+	}
+
+	if len(queries) == 0 {
+		return nil, nil
+	}
+	if len(queries) == 1 {
+		return queries[0], nil
+	}
+	query := NewBooleanQuery()
+	for _, q := range queries {
+		query.Add(q, occur)
+	}
+	return query, nil
+}
+
+// split split the query text into the words of each field and the phrases
+func (qp *QueryParser) split(text string) ([]queryClause, error) {
+	clauses := []queryClause{}
+	rest := strings.TrimLeftFunc(text, unicode.IsSpace)
+	for len(rest) > 0 {
+		field := qp.DefaultField
+		word := strings.IndexFunc(rest, unicode.IsSpace)
+		if word < 0 {
+			word = len(rest)
+		}
+		if colon := strings.IndexByte(rest[:word], ':'); colon > 0 && !strings.ContainsRune(rest[:colon], '"') {
+			field = rest[:colon]
+			rest = rest[colon+1:]
+		}
+
+		if strings.HasPrefix(rest, "\"") {
+			end := strings.IndexByte(rest[1:], '"')
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated phrase %s", rest)
+			}
+			clauses = append(clauses, queryClause{field: field, text: rest[1 : end+1], phrase: true})
+			rest = rest[end+2:]
+		} else {
+			word = strings.IndexFunc(rest, unicode.IsSpace)
+			if word < 0 {
+				word = len(rest)
+			}
+			n := len(clauses)
+			if n > 0 && !clauses[n-1].phrase && clauses[n-1].field == field {
+				clauses[n-1].text = clauses[n-1].text + " " + rest[:word]
+			} else {
+				clauses = append(clauses, queryClause{field: field, text: rest[:word]})
+			}
+			rest = rest[word:]
+		}
+		rest = strings.TrimLeftFunc(rest, unicode.IsSpace)
+	}
+	return clauses, nil
+}
+
+// tokenGraph positions of the tokens of a stream, the first one at position 0
+func tokenGraph(tokens []Token) []graphToken {
+	graph := []graphToken{}
+	position := int64(-1)
+	for _, t := range tokens {
+		position = position + t.PositionIncrement
+		if position < 0 {
+			position = 0
+		}
+		length := t.PositionLength
+		if length < 1 {
+			length = 1
+		}
+		graph = append(graph, graphToken{text: t.TermText, start: position, end: position + length})
+	}
+	return graph
+}
+
+// graphParts split a graph at the positions no token spans, each part is a word or its synonyms
+func graphParts(graph []graphToken) [][]graphToken {
+	parts := [][]graphToken{}
+	begin := 0
+	end := graph[0].end
+	for i := 1; i < len(graph); i = i + 1 {
+		if graph[i].start >= end {
+			parts = append(parts, graph[begin:i])
+			begin = i
+		}
+		if graph[i].end > end {
+			end = graph[i].end
+		}
+	}
+	return append(parts, graph[begin:])
+}
+
+// graphQuery query of the paths through a graph, a term for a path of one token, a phrase for the others
+func graphQuery(fieldName string, graph []graphToken) Query {
+	paths := graphPaths(graph)
+	if len(paths) == 1 {
+		return pathQuery(fieldName, paths[0])
+	}
+	query := NewBooleanQuery()
+	for _, path := range paths {
+		query.Add(pathQuery(fieldName, path), OccurShould)
+	}
+	return query
+}
+
+// pathQuery term or phrase of a path
+func pathQuery(fieldName string, path []string) Query {
+	if len(path) == 1 {
+		return NewTermQuery(fieldName, path[0])
+	}
+	return NewPhraseQuery(fieldName, path...)
+}
+
+// graphPaths texts of the paths from the first position of a graph to its last,
+// a position no token starts at, a hole left by a removed token, is stepped over
+func graphPaths(graph []graphToken) [][]string {
+	last := int64(0)
+	for _, t := range graph {
+		if t.end > last {
+			last = t.end
+		}
+	}
+	paths := [][]string{}
+	var walk func(position int64, path []string)
+	walk = func(position int64, path []string) {
+		if position >= last {
+			paths = append(paths, append([]string(nil), path...))
+			return
+		}
+		next := last
+		seen := map[string]bool{}
+		for _, t := range graph {
+			if t.start == position && !seen[t.text] {
+				seen[t.text] = true
+				walk(t.end, append(path, t.text))
+			}
+			if t.start > position && t.start < next {
+				next = t.start
+			}
+		}
+		if len(seen) == 0 {
+			walk(next, path)
+		}
+	}
+	walk(graph[0].start, nil)
+	return paths
+}
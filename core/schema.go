@@ -0,0 +1,330 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+)
+
+/*
+A Schema declares the fields of an index up front,
+so a document with an unknown field, a value of the wrong type or other options than declared is rejected,
+instead of silently changing how the field is indexed.
+Without a schema the fields are declared by the documents that use them.
+
+A field has a type, text (indexed and tokenized), keyword (not tokenized) or binary (stored bytes),
+or no type when it only has doc values, and its options:
+
+	{
+		"fields": [
+			{"name": "title", "type": "text", "analyzer": "poem", "stored": true, "indexed": true,
+			 "term_vector": "positions_offsets"},
+			{"name": "paragraphs", "type": "text", "stored": true, "indexed": true, "multi_valued": true},
+			{"name": "dynasty", "type": "keyword", "stored": true, "indexed": true, "doc_values": "sorted"},
+			{"name": "year", "doc_values": "numeric"}
+		]
+	}
+
+term_vector is one of yes, positions, offsets or positions_offsets,
+doc_values one of numeric, sorted, sorted_set or binary, multi_valued allows several values in a document.
+The schema of a writer is kept in the schema file of the index,
+it may gain fields later, but a declared field never changes,
+and a field the index already has must be declared as the index has it.
+The analyzer of a text field is looked up in the AnalyzerRegistry of the writer config,
+and analyzes the field instead of the analyzer of the config.
+*/
+
+// Schema declared fields of an index
+type Schema struct {
+	fields []FieldSchema
+	byName map[string]int
+}
+
+// FieldSchema declaration of a field
+type FieldSchema struct {
+	Name        string `json:"name"`
+	Type        string `json:"type,omitempty"`     // text, keyword or binary, empty for doc values only
+	Analyzer    string `json:"analyzer,omitempty"` // analyzer of a text field, by registry name
+	Stored      bool   `json:"stored,omitempty"`
+	Indexed     bool   `json:"indexed,omitempty"`
+	TermVector  string `json:"term_vector,omitempty"`
+	DocValues   string `json:"doc_values,omitempty"`
+	MultiValued bool   `json:"multi_valued,omitempty"`
+}
+
+// schemaJSON json form of a schema
+type schemaJSON struct {
+	Fields []FieldSchema `json:"fields"`
+}
+
+var termVectorNames = map[TermVector]string{
+	TermVectorNo:                   "",
+	TermVectorYes:                  "yes",
+	TermVectorWithPositions:        "positions",
+	TermVectorWithOffsets:          "offsets",
+	TermVectorWithPositionsOffsets: "positions_offsets",
+}
+
+var docValuesNames = map[DocValuesType]string{
+	DocValuesNone:      "",
+	DocValuesNumeric:   "numeric",
+	DocValuesSorted:    "sorted",
+	DocValuesSortedSet: "sorted_set",
+	DocValuesBinary:    "binary",
+}
+
+// NewSchema new schema of fields
+func NewSchema(fields ...FieldSchema) (*Schema, error) {
+	s := &Schema{byName: map[string]int{}}
+	for _, f := range fields {
+		err := s.AddField(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// ParseSchema schema of a json config
+func ParseSchema(reader io.Reader) (*Schema, error) {
+	var config schemaJSON
+	decoder := json.NewDecoder(reader)
+	decoder.DisallowUnknownFields()
+	err := decoder.Decode(&config)
+	if err != nil {
+		return nil, fmt.Errorf("schema: %v", err)
+	}
+	return NewSchema(config.Fields...)
+}
+
+// readIndexSchema schema of an index, nil when it has none
+func readIndexSchema(dirPath string) (*Schema, error) {
+	f, err := os.Open(path.Join(dirPath, "schema"))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseSchema(f)
+}
+
+// ================================Schema=======================================
+
+// AddField declare a field
+func (s *Schema) AddField(f FieldSchema) error {
+	if _, found := s.byName[f.Name]; found {
+		return fmt.Errorf("schema: field %s declared twice", f.Name)
+	}
+	err := f.check()
+	if err != nil {
+		return err
+	}
+	s.byName[f.Name] = len(s.fields)
+	s.fields = append(s.fields, f)
+	return nil
+}
+
+// Fields declared fields, in order
+func (s *Schema) Fields() []FieldSchema {
+	fields := make([]FieldSchema, len(s.fields))
+	copy(fields, s.fields)
+	return fields
+}
+
+// Field declaration of a field
+func (s *Schema) Field(name string) (FieldSchema, bool) {
+	i, found := s.byName[name]
+	if !found {
+		return FieldSchema{}, false
+	}
+	return s.fields[i], true
+}
+
+// WriteJSON write the schema as json
+func (s *Schema) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "\t")
+	return encoder.Encode(schemaJSON{Fields: s.fields})
+}
+
+// write write the schema file of an index
+func (s *Schema) write(dirPath string) error {
+	newPath := path.Join(dirPath, "schema.new")
+	f, err := os.Create(newPath)
+	if err != nil {
+		return err
+	}
+	err = s.WriteJSON(f)
+	if e := f.Close(); err == nil {
+		err = e
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(newPath, path.Join(dirPath, "schema"))
+}
+
+// extends every field of old is declared the same
+func (s *Schema) extends(old *Schema) error {
+	for _, f := range old.fields {
+		g, found := s.Field(f.Name)
+		if !found {
+			return fmt.Errorf("schema: field %s of the index is not declared", f.Name)
+		}
+		if g != f {
+			return fmt.Errorf("schema: field %s is declared %+v, the index has %+v", f.Name, g, f)
+		}
+	}
+	return nil
+}
+
+// agrees every declared field the index already has is declared as it was indexed,
+// segments written before the untokenized flag of the field infos count as tokenized
+func (s *Schema) agrees(fieldInfos *FieldInfos) error {
+	for _, fi := range fieldInfos.byNumber {
+		f, found := s.Field(fi.name)
+		if !found {
+			continue
+		}
+		typ := "text"
+		if fi.untokenized {
+			typ = "keyword"
+		}
+		switch {
+		case fi.isIndexed && !f.Indexed:
+			return fmt.Errorf("schema: field %s is indexed by the index, declared not indexed", fi.name)
+		case fi.isIndexed && f.Type != typ:
+			return fmt.Errorf("schema: field %s is indexed as %s by the index, declared %s", fi.name, typ, f.Type)
+		case fi.isIndexed && termVectorNames[fi.termVector()] != f.TermVector:
+			return fmt.Errorf("schema: field %s has term vector %q in the index, declared %q",
+				fi.name, termVectorNames[fi.termVector()], f.TermVector)
+		case fi.docValuesType != DocValuesNone && docValuesNames[fi.docValuesType] != f.DocValues:
+			return fmt.Errorf("schema: field %s has %s doc values in the index, declared %q", fi.name, fi.docValuesType, f.DocValues)
+		}
+	}
+	return nil
+}
+
+// analyzes the schema names an analyzer for some field
+func (s *Schema) analyzes() bool {
+	for _, f := range s.fields {
+		if f.Analyzer != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// PerFieldAnalyzer analyzer of the text fields by their analyzer names in registry,
+// defaultAnalyzer for the fields without one
+func (s *Schema) PerFieldAnalyzer(registry *AnalyzerRegistry, defaultAnalyzer Analyzer) (*PerFieldAnalyzerWrapper, error) {
+	wrapper := NewPerFieldAnalyzerWrapper(defaultAnalyzer, nil)
+	for _, f := range s.fields {
+		if f.Analyzer == "" {
+			continue
+		}
+		analyzer, err := registry.Analyzer(f.Analyzer)
+		if err != nil {
+			return nil, fmt.Errorf("schema: field %s: %v", f.Name, err)
+		}
+		wrapper.AddAnalyzer(f.Name, analyzer)
+	}
+	return wrapper, nil
+}
+
+// Validate check the fields of a document against the schema
+func (s *Schema) Validate(doc Document) error {
+	values := map[string]int{}
+	for _, field := range doc.Fields {
+		f, found := s.Field(field.name)
+		if !found {
+			return fmt.Errorf("schema: unknown field %s", field.name)
+		}
+
+		if field.docValuesType != DocValuesNone {
+			if docValuesNames[field.docValuesType] != f.DocValues {
+				return fmt.Errorf("schema: field %s has %s doc values, declared %q", field.name, field.docValuesType, f.DocValues)
+			}
+			continue
+		}
+
+		values[field.name] = values[field.name] + 1
+		if values[field.name] > 1 && !f.MultiValued {
+			return fmt.Errorf("schema: field %s has several values, declared single valued", field.name)
+		}
+		typ := "keyword"
+		if field.binary != nil {
+			typ = "binary"
+		} else if field.isTokenized {
+			typ = "text"
+		}
+		switch {
+		case f.Type == "":
+			return fmt.Errorf("schema: field %s has a %s value, declared doc values only", field.name, typ)
+		case typ != f.Type:
+			return fmt.Errorf("schema: field %s has a %s value, declared %s", field.name, typ, f.Type)
+		case field.isStored != f.Stored:
+			return fmt.Errorf("schema: field %s is stored %t, declared %t", field.name, field.isStored, f.Stored)
+		case field.isIndexed != f.Indexed:
+			return fmt.Errorf("schema: field %s is indexed %t, declared %t", field.name, field.isIndexed, f.Indexed)
+		case termVectorNames[field.termVector] != f.TermVector:
+			return fmt.Errorf("schema: field %s has term vector %q, declared %q", field.name, termVectorNames[field.termVector], f.TermVector)
+		}
+	}
+	return nil
+}
+
+// ================================FieldSchema=======================================
+
+// check the options of the field agree
+func (f *FieldSchema) check() error {
+	if f.Name == "" {
+		return fmt.Errorf("schema: field without a name")
+	}
+	found := false
+	for _, name := range docValuesNames {
+		found = found || name == f.DocValues
+	}
+	if !found {
+		return fmt.Errorf("schema: field %s: unknown doc values %q", f.Name, f.DocValues)
+	}
+	found = false
+	for _, name := range termVectorNames {
+		found = found || name == f.TermVector
+	}
+	if !found {
+		return fmt.Errorf("schema: field %s: unknown term vector %q", f.Name, f.TermVector)
+	}
+
+	switch f.Type {
+	case "":
+		if f.DocValues == "" {
+			return fmt.Errorf("schema: field %s has neither a type nor doc values", f.Name)
+		}
+		if f.Stored || f.Indexed || f.MultiValued {
+			return fmt.Errorf("schema: doc values only field %s can not be stored, indexed or multi valued", f.Name)
+		}
+	case "text":
+		if !f.Indexed {
+			return fmt.Errorf("schema: text field %s must be indexed", f.Name)
+		}
+	case "keyword":
+	case "binary":
+		if !f.Stored || f.Indexed {
+			return fmt.Errorf("schema: binary field %s must be stored and not indexed", f.Name)
+		}
+	default:
+		return fmt.Errorf("schema: field %s: unknown type %q", f.Name, f.Type)
+	}
+	if f.Analyzer != "" && f.Type != "text" {
+		return fmt.Errorf("schema: field %s: analyzer of a %s field", f.Name, f.Type)
+	}
+	if f.TermVector != "" && !f.Indexed {
+		return fmt.Errorf("schema: field %s: term vector of an unindexed field", f.Name)
+	}
+	return nil
+}
@@ -0,0 +1,300 @@
+package core
+
+import (
+	"math"
+	"sort"
+)
+
+/*
+A Query finds the documents of an index matching it, each with a score of how well it matches.
+Matches returns the hits in document order, an IndexSearcher keeps the best scored of them.
+
+A TermQuery matches the documents containing a term, scored
+
+	sqrt(freq) * idf * idf * norm
+
+where freq is the frequency of the term in the document, idf = 1 + ln(numDocs / (docFreq + 1)),
+and norm the length norm of the field in the document, so a term of a short field scores higher.
+
+A PhraseQuery matches the documents containing its terms at consecutive positions, scored as a term
+whose freq is the number of times the phrase occurs and whose idf is the sum of the idfs of its terms.
+
+A BooleanQuery combines queries, a hit matches all its must clauses, and at least one should clause
+when it has no must clause, its score is the sum of the scores of the clauses it matches.
+*/
+
+// Query query of an index
+type Query interface {
+	Matches(reader *IndexReader) ([]ScoreDoc, error) // hits in document order
+}
+
+// ScoreDoc a hit of a query
+type ScoreDoc struct {
+	Doc   int64   // index document number
+	Score float64 // how well the document matches
+}
+
+// TopDocs best hits of a search
+type TopDocs struct {
+	TotalHits int64      // number of documents matching
+	ScoreDocs []ScoreDoc // best hits, by decreasing score
+}
+
+// IndexSearcher searcher of an index reader
+type IndexSearcher struct {
+	reader *IndexReader
+}
+
+// TermQuery documents containing a term
+type TermQuery struct {
+	term Term
+}
+
+// PhraseQuery documents containing terms at consecutive positions
+type PhraseQuery struct {
+	field string
+	terms []string
+}
+
+// Occur how a clause of a BooleanQuery matches
+type Occur int
+
+const (
+	// OccurShould the clause may match
+	OccurShould Occur = iota
+	// OccurMust the clause must match
+	OccurMust
+)
+
+// BooleanClause query of a BooleanQuery and how it matches
+type BooleanClause struct {
+	Query Query
+	Occur Occur
+}
+
+// BooleanQuery documents matching a combination of queries
+type BooleanQuery struct {
+	clauses []BooleanClause
+}
+
+// NewIndexSearcher searcher of a reader
+func NewIndexSearcher(reader *IndexReader) *IndexSearcher {
+	return &IndexSearcher{reader: reader}
+}
+
+// NewTermQuery query of the term text in a field
+func NewTermQuery(fieldName, text string) *TermQuery {
+	return &TermQuery{term: Term{field: fieldName, text: text}}
+}
+
+// NewPhraseQuery query of the terms at consecutive positions of a field
+func NewPhraseQuery(fieldName string, terms ...string) *PhraseQuery {
+	return &PhraseQuery{field: fieldName, terms: terms}
+}
+
+// NewBooleanQuery query of clauses
+func NewBooleanQuery(clauses ...BooleanClause) *BooleanQuery {
+	return &BooleanQuery{clauses: clauses}
+}
+
+// ================================IndexSearcher=======================================
+
+// Reader reader searched
+func (s *IndexSearcher) Reader() *IndexReader {
+	return s.reader
+}
+
+// Search n best hits of a query, ties by document order
+func (s *IndexSearcher) Search(query Query, n int) (TopDocs, error) {
+	hits, err := query.Matches(s.reader)
+	if err != nil {
+		return TopDocs{}, err
+	}
+	top := TopDocs{TotalHits: int64(len(hits))}
+	sort.SliceStable(hits, func(i, j int) bool {
+		return hits[i].Score > hits[j].Score
+	})
+	if len(hits) > n {
+		hits = hits[:n]
+	}
+	top.ScoreDocs = hits
+	return top, nil
+}
+
+// ================================TermQuery=======================================
+
+// Term field and text of the term
+func (q *TermQuery) Term() (string, string) {
+	return q.term.field, q.term.text
+}
+
+// Matches documents containing the term
+func (q *TermQuery) Matches(reader *IndexReader) ([]ScoreDoc, error) {
+	docFreq := reader.DocFreq(q.term.field, q.term.text)
+	if docFreq == 0 {
+		return nil, nil
+	}
+	idf := 1 + math.Log(float64(reader.NumDocs())/float64(docFreq+1))
+
+	hits := []ScoreDoc{}
+	for i, sr := range reader.readers {
+		ti, found := sr.termInfo(q.term)
+		if !found {
+			continue
+		}
+		termDocs, err := sr.readPostings(ti)
+		if err != nil {
+			return nil, err
+		}
+		norms, err := sr.normBytes(q.term.field)
+		if err != nil {
+			return nil, err
+		}
+		for _, td := range termDocs {
+			if sr.isDeleted(td.Doc) {
+				continue
+			}
+			norm := 1.0
+			if norms != nil {
+				norm = float64(norms[td.Doc]) / 255
+			}
+			score := math.Sqrt(float64(td.Freq)) * idf * idf * norm
+			hits = append(hits, ScoreDoc{Doc: reader.starts[i] + td.Doc, Score: score})
+		}
+	}
+	return hits, nil
+}
+
+// ================================PhraseQuery=======================================
+
+// Field field of the phrase
+func (q *PhraseQuery) Field() string {
+	return q.field
+}
+
+// Terms terms of the phrase in order
+func (q *PhraseQuery) Terms() []string {
+	return q.terms
+}
+
+// Matches documents containing the phrase
+func (q *PhraseQuery) Matches(reader *IndexReader) ([]ScoreDoc, error) {
+	if len(q.terms) == 0 {
+		return nil, nil
+	}
+	idf := 0.0
+	for _, text := range q.terms {
+		docFreq := reader.DocFreq(q.field, text)
+		if docFreq == 0 {
+			return nil, nil
+		}
+		idf = idf + 1 + math.Log(float64(reader.NumDocs())/float64(docFreq+1))
+	}
+
+	hits := []ScoreDoc{}
+	for i, sr := range reader.readers {
+		postings := make([]map[int64][]int64, len(q.terms)) // positions by document of each term
+		for j, text := range q.terms {
+			termDocs, err := sr.TermDocs(q.field, text)
+			if err != nil {
+				return nil, err
+			}
+			postings[j] = make(map[int64][]int64, len(termDocs))
+			for _, td := range termDocs {
+				postings[j][td.Doc] = td.Positions
+			}
+		}
+		first, err := sr.TermDocs(q.field, q.terms[0])
+		if err != nil {
+			return nil, err
+		}
+		norms, err := sr.normBytes(q.field)
+		if err != nil {
+			return nil, err
+		}
+		for _, td := range first {
+			freq := phraseFreq(postings, td.Doc)
+			if freq == 0 {
+				continue
+			}
+			norm := 1.0
+			if norms != nil {
+				norm = float64(norms[td.Doc]) / 255
+			}
+			score := math.Sqrt(float64(freq)) * idf * idf * norm
+			hits = append(hits, ScoreDoc{Doc: reader.starts[i] + td.Doc, Score: score})
+		}
+	}
+	return hits, nil
+}
+
+// phraseFreq number of positions of a document where term j of the phrase is at position+j for each j
+func phraseFreq(postings []map[int64][]int64, doc int64) int64 {
+	sets := make([]map[int64]bool, len(postings))
+	for j := 1; j < len(postings); j = j + 1 {
+		positions, found := postings[j][doc]
+		if !found {
+			return 0
+		}
+		sets[j] = make(map[int64]bool, len(positions))
+		for _, p := range positions {
+			sets[j][p] = true
+		}
+	}
+	freq := int64(0)
+	for _, p := range postings[0][doc] {
+		j := 1
+		for j < len(sets) && sets[j][p+int64(j)] {
+			j = j + 1
+		}
+		if j == len(sets) {
+			freq = freq + 1
+		}
+	}
+	return freq
+}
+
+// ================================BooleanQuery=======================================
+
+// Add add a clause
+func (q *BooleanQuery) Add(query Query, occur Occur) {
+	q.clauses = append(q.clauses, BooleanClause{Query: query, Occur: occur})
+}
+
+// Clauses clauses of the query
+func (q *BooleanQuery) Clauses() []BooleanClause {
+	return q.clauses
+}
+
+// Matches documents matching the must clauses, or some should clause when there is none
+func (q *BooleanQuery) Matches(reader *IndexReader) ([]ScoreDoc, error) {
+	musts := 0
+	scores := map[int64]float64{}
+	mustMatches := map[int64]int{}
+	for _, clause := range q.clauses {
+		hits, err := clause.Query.Matches(reader)
+		if err != nil {
+			return nil, err
+		}
+		if clause.Occur == OccurMust {
+			musts = musts + 1
+		}
+		for _, hit := range hits {
+			scores[hit.Doc] = scores[hit.Doc] + hit.Score
+			if clause.Occur == OccurMust {
+				mustMatches[hit.Doc] = mustMatches[hit.Doc] + 1
+			}
+		}
+	}
+
+	hits := []ScoreDoc{}
+	for doc, score := range scores {
+		if mustMatches[doc] == musts {
+			hits = append(hits, ScoreDoc{Doc: doc, Score: score})
+		}
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		return hits[i].Doc < hits[j].Doc
+	})
+	return hits, nil
+}
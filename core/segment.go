@@ -1,7 +1,9 @@
 package core
 
 import (
+	"container/heap"
 	"fmt"
+	"os"
 	"path"
 	"strconv"
 )
@@ -10,13 +12,16 @@ import (
 type SegmentInfo struct {
 	name     string
 	docCount int64
+	delCount int64 // deleted documents
 	dirPath  string
+	gen      int64 // deletes of a larger gen, buffered by the writer, apply to the segment
 }
 
 // SegmentInfos segment infos
 type SegmentInfos struct {
 	counter  int64
 	segInfos []SegmentInfo
+	merging  map[string]bool // segments of pending and running merges, as the merge policy is asked
 }
 
 // Norm norm
@@ -27,11 +32,16 @@ type Norm struct {
 
 // SegmentReader segment reader
 type SegmentReader struct {
-	seg          *SegmentInfo      // segmentInfo Ptr
-	fieldInfos   *FieldInfos       // fieldInfos
-	fieldsReader *FieldsReader     // fields reader
-	termsReader  *TermsReader      // terms reader
-	norms        *map[string]*Norm // norms
+	seg          *SegmentInfo                // segmentInfo Ptr
+	fieldInfos   *FieldInfos                 // fieldInfos
+	fieldsReader *FieldsReader               // fields reader
+	termsReader  *TermsReader                // terms reader
+	freqStream   *File                       // frq file
+	proxStream   *File                       // prx file
+	norms        *map[string]*Norm           // norms
+	tvReader     *TermVectorsReader          // term vectors reader, nil without vectors
+	docValues    map[string]*docValuesColumn // doc values read so far
+	deletedDocs  *BitSet                     // deleted documents, nil when none
 }
 
 // SegmentMerger segment merger
@@ -39,8 +49,12 @@ type SegmentMerger struct {
 	dirPath    string           // segment dir
 	name       string           // segment name
 	readers    []*SegmentReader // segment reader
+	docMaps    [][]int64        // merged number of each document of each reader, -1 when deleted
+	docCount   int64            // documents of the merged segment
 	fieldInfos *FieldInfos
 	tw         *TermsWriter
+	config     *IndexWriterConfig // settings of the merged segment
+	progress   func() error       // called at each step of the merge, stops it on error, nil for none
 }
 
 // SegmentMergeInfo segment merge info
@@ -50,6 +64,7 @@ type SegmentMergeInfo struct {
 	termInfo *TermInfo
 	base     int64
 	reader   *SegmentReader
+	docMap   []int64 // merged number of each document of the reader, -1 when deleted
 	// postings
 }
 
@@ -77,7 +92,7 @@ func (s *SegmentInfos) add(segInfo SegmentInfo) error {
 	return nil
 }
 
-// Write create file
+// Write write the segments file, to segments.new renamed to segments, so a reader sees the old or the new file
 func (s *SegmentInfos) write(fPtr *File) error {
 	filePath := path.Join(fPtr.filePath, "segments.new")
 
@@ -86,17 +101,72 @@ func (s *SegmentInfos) write(fPtr *File) error {
 		return err
 	}
 
-	sPtr.writeInt(int(s.counter))
-	sPtr.writeInt(len(s.segInfos))
-
+	err = sPtr.writeInt(int(s.counter))
+	if err == nil {
+		err = sPtr.writeInt(len(s.segInfos))
+	}
 	for _, seg := range s.segInfos {
-		sPtr.writeString(seg.name)
-		sPtr.writeInt(int(seg.docCount))
+		if err == nil {
+			err = sPtr.writeString(seg.name)
+		}
+		if err == nil {
+			err = sPtr.writeInt(int(seg.docCount))
+		}
+	}
+	if err != nil {
+		sPtr.close()
+		os.Remove(filePath)
+		return err
 	}
 
-	sPtr.close()
+	err = sPtr.close()
+	if err != nil {
+		os.Remove(filePath)
+		return err
+	}
 	nfilepath := path.Join(fPtr.filePath, "segments")
-	sPtr.rename(nfilepath)
+	return sPtr.rename(nfilepath)
+}
+
+// read read the segments file of a directory
+func (s *SegmentInfos) read(dirPath string) error {
+	sPtr, err := CreateFile(path.Join(dirPath, "segments"), false, true)
+	if err != nil {
+		return err
+	}
+	defer sPtr.close()
+
+	counter, err := sPtr.readInt()
+	if err != nil {
+		return err
+	}
+	n, err := sPtr.readInt()
+	if err != nil {
+		return err
+	}
+
+	s.counter = int64(counter)
+	s.segInfos = []SegmentInfo{}
+	for i := 0; i < n; i++ {
+		name, err := sPtr.readString()
+		if err != nil {
+			return err
+		}
+		docCount, err := sPtr.readInt()
+		if err != nil {
+			return err
+		}
+		delCount, err := readDeletedCount(dirPath, name)
+		if err != nil {
+			return err
+		}
+		s.segInfos = append(s.segInfos, SegmentInfo{
+			name:     name,
+			docCount: int64(docCount),
+			delCount: delCount,
+			dirPath:  dirPath,
+		})
+	}
 	return nil
 }
 
@@ -116,9 +186,17 @@ func (sr *SegmentReader) maxDoc() int64 {
 	return sr.fieldsReader.size
 }
 
-// numDocs
+// numDocs documents not deleted
 func (sr *SegmentReader) numDocs() int64 {
-	return sr.fieldsReader.size
+	if sr.deletedDocs == nil {
+		return sr.maxDoc()
+	}
+	return sr.maxDoc() - sr.deletedDocs.Cardinality()
+}
+
+// isDeleted document n is deleted
+func (sr *SegmentReader) isDeleted(n int64) bool {
+	return sr.deletedDocs != nil && sr.deletedDocs.Get(n)
 }
 
 // Init segment reader init
@@ -132,30 +210,191 @@ func (sr *SegmentReader) init(si SegmentInfo) error {
 	sr.fieldInfos = fieldsPtr
 
 	// deserialize fnm info
-	sr.initFieldNames()
+	err := sr.initFieldNames()
+	if err != nil {
+		return err
+	}
 
 	// fields reader
 	fr := new(FieldsReader)
-	fr.init(si.dirPath, si.name, sr.fieldInfos)
+	err = fr.init(si.dirPath, si.name, sr.fieldInfos)
+	if err != nil {
+		return err
+	}
 	sr.fieldsReader = fr
 
 	// terms info
 	tr := new(TermsReader)
-	tr.init(si.dirPath, si.name, sr.fieldInfos)
+	err = tr.init(si.dirPath, si.name, sr.fieldInfos)
+	if err != nil {
+		return err
+	}
 
 	sr.termsReader = tr
-	sr.openNorms()
 
-	return nil
+	// postings
+	sr.freqStream, err = CreateFile(path.Join(si.dirPath, si.name+FileSuffix["termFrequencies"]), false, true)
+	if err != nil {
+		return err
+	}
+	sr.proxStream, err = CreateFile(path.Join(si.dirPath, si.name+FileSuffix["termPositions"]), false, true)
+	if err != nil {
+		return err
+	}
+
+	// term vectors
+	if sr.fieldInfos.hasVectors() {
+		tvr := new(TermVectorsReader)
+		found, err := tvr.init(si.dirPath, si.name, sr.fieldInfos)
+		if err != nil {
+			return err
+		}
+		if found {
+			sr.tvReader = tvr
+		}
+	}
+
+	// deleted documents
+	sr.deletedDocs, err = readDeletedDocs(si.dirPath, si.name)
+	if err != nil {
+		return err
+	}
+	return sr.openNorms()
+}
+
+// docValuesColumn column of a doc values field, nil when no document of the segment has a value
+func (sr *SegmentReader) docValuesColumn(fieldName string, typ DocValuesType) (*docValuesColumn, error) {
+	fi, found := sr.fieldInfos.byName[fieldName]
+	if !found || fi.docValuesType == DocValuesNone {
+		return nil, nil
+	}
+	if fi.docValuesType != typ {
+		return nil, fmt.Errorf("field %s has %s doc values, not %s", fieldName, fi.docValuesType, typ)
+	}
+	if column, found := sr.docValues[fieldName]; found {
+		return column, nil
+	}
+	column, err := readDocValues(docValuesPath(sr.seg.dirPath, sr.seg.name, fi.number))
+	if err != nil {
+		return nil, err
+	}
+	if sr.docValues == nil {
+		sr.docValues = map[string]*docValuesColumn{}
+	}
+	sr.docValues[fieldName] = column
+	return column, nil
+}
+
+// docValuesOrMissing column of a field, a column without values when the segment has none
+func (sr *SegmentReader) docValuesOrMissing(fieldName string, typ DocValuesType) (*docValuesColumn, error) {
+	column, err := sr.docValuesColumn(fieldName, typ)
+	if err != nil || column != nil {
+		return column, err
+	}
+	column = newDocValuesColumn(typ)
+	column.addMissing(sr.maxDoc())
+	return column, nil
+}
+
+// NumericDocValues numeric doc values of a field
+func (sr *SegmentReader) NumericDocValues(fieldName string) (*NumericDocValues, error) {
+	column, err := sr.docValuesOrMissing(fieldName, DocValuesNumeric)
+	if err != nil {
+		return nil, err
+	}
+	return &NumericDocValues{column: column}, nil
+}
+
+// SortedDocValues sorted doc values of a field
+func (sr *SegmentReader) SortedDocValues(fieldName string) (*SortedDocValues, error) {
+	column, err := sr.docValuesOrMissing(fieldName, DocValuesSorted)
+	if err != nil {
+		return nil, err
+	}
+	return &SortedDocValues{column: column}, nil
+}
+
+// SortedSetDocValues sorted set doc values of a field
+func (sr *SegmentReader) SortedSetDocValues(fieldName string) (*SortedSetDocValues, error) {
+	column, err := sr.docValuesOrMissing(fieldName, DocValuesSortedSet)
+	if err != nil {
+		return nil, err
+	}
+	return &SortedSetDocValues{column: column}, nil
+}
+
+// BinaryDocValues binary doc values of a field
+func (sr *SegmentReader) BinaryDocValues(fieldName string) (*BinaryDocValues, error) {
+	column, err := sr.docValuesOrMissing(fieldName, DocValuesBinary)
+	if err != nil {
+		return nil, err
+	}
+	return &BinaryDocValues{column: column}, nil
+}
+
+// MaxDoc number of documents of the segment, deleted or not
+func (sr *SegmentReader) MaxDoc() int64 {
+	return sr.maxDoc()
+}
+
+// NumDocs number of documents of the segment not deleted
+func (sr *SegmentReader) NumDocs() int64 {
+	return sr.numDocs()
+}
+
+// IsDeleted document n of the segment is deleted
+func (sr *SegmentReader) IsDeleted(n int64) bool {
+	return sr.isDeleted(n)
+}
+
+// termVector term vector of a field of document n, nil when it has none
+func (sr *SegmentReader) termVector(n int64, fieldName string) (*TermFreqVector, error) {
+	if sr.tvReader == nil {
+		return nil, nil
+	}
+	return sr.tvReader.fieldVector(n, fieldName)
+}
+
+// close close the segment files
+func (sr *SegmentReader) close() error {
+	var err error
+	if sr.fieldsReader != nil {
+		err = sr.fieldsReader.close()
+	}
+	if sr.termsReader != nil {
+		if e := sr.termsReader.close(); err == nil {
+			err = e
+		}
+	}
+	for _, stream := range []*File{sr.freqStream, sr.proxStream} {
+		if stream == nil {
+			continue
+		}
+		if e := stream.close(); err == nil {
+			err = e
+		}
+	}
+	if sr.tvReader != nil {
+		if e := sr.tvReader.close(); err == nil {
+			err = e
+		}
+	}
+	if sr.norms != nil {
+		for _, norm := range *sr.norms {
+			if e := norm.fPtr.close(); err == nil {
+				err = e
+			}
+		}
+	}
+	return err
 }
 
 // InitFieldNames deserialize fnm info
 func (sr *SegmentReader) initFieldNames() error {
 
 	var (
-		err       error
-		filepath  string
-		isIndexed bool
+		err      error
+		filepath string
 	)
 
 	filepath = path.Join(sr.seg.dirPath, sr.seg.name+FileSuffix["fieldName"])
@@ -164,8 +403,12 @@ func (sr *SegmentReader) initFieldNames() error {
 	if err != nil {
 		return err
 	}
+	defer f.close()
 
 	n, err := f.readVarInt() // (1) get field count
+	if err != nil {
+		return err
+	}
 
 	for n > 0 {
 
@@ -176,15 +419,15 @@ func (sr *SegmentReader) initFieldNames() error {
 		}
 
 		b, err := f.readByte()
-		if b == 1 {
-			isIndexed = true
+		if err != nil {
+			return err
 		}
 
 		fi := FieldInfo{
-			name:      s,
-			isIndexed: isIndexed,
-			number:    int64(len(sr.fieldInfos.byNumber)),
+			name:   s,
+			number: int64(len(sr.fieldInfos.byNumber)),
 		}
+		fi.setIndexByte(b)
 
 		// init fieldInfos
 		sr.fieldInfos.byNumber = append(sr.fieldInfos.byNumber, fi)
@@ -208,8 +451,7 @@ func (sr *SegmentReader) openNorms() error {
 			}
 
 			norm := Norm{
-				fPtr:  fPtr,
-				bytes: []byte{},
+				fPtr: fPtr,
 			}
 			(*sr.norms)[fi.name] = &norm
 		}
@@ -217,17 +459,6 @@ func (sr *SegmentReader) openNorms() error {
 	return nil
 }
 
-// normStream norm stream
-func (sr *SegmentReader) normStream(field string) (*File, error) {
-	if norm, ok := (*sr.norms)[field]; ok {
-		fPtr := norm.fPtr // why in lucene is clone inputStream?
-		fPtr.seekFrom(0)
-		return fPtr, nil
-	}
-	return nil, fmt.Errorf("no such norm")
-
-}
-
 // ================================SegmentMerger=======================================
 
 // Add add reader
@@ -236,17 +467,96 @@ func (sm *SegmentMerger) add(r *SegmentReader) error {
 	return nil
 }
 
-// merge merge segment
+// checkProgress report a step of the merge
+func (sm *SegmentMerger) checkProgress() error {
+	if sm.progress == nil {
+		return nil
+	}
+	return sm.progress()
+}
+
+// mapDocs number the documents not deleted of the readers, one after the other
+func (sm *SegmentMerger) mapDocs() {
+	sm.docMaps = make([][]int64, len(sm.readers))
+	sm.docCount = 0
+	for i, r := range sm.readers {
+		docMap := make([]int64, r.maxDoc())
+		for doc := range docMap {
+			if r.isDeleted(int64(doc)) {
+				docMap[doc] = -1
+				continue
+			}
+			docMap[doc] = sm.docCount
+			sm.docCount = sm.docCount + 1
+		}
+		sm.docMaps[i] = docMap
+	}
+}
+
+// merge merge segment, dropping the deleted documents
 func (sm *SegmentMerger) merge() error {
 
+	sm.mapDocs()
+
+	err := removeDeletedDocs(sm.dirPath, sm.name)
+	if err != nil {
+		return err
+	}
+
 	sm.mergeFieldNames() // (1) merge field names
 
-	sm.mergeFieldValues() // (2) merge field values
+	err = sm.mergeFieldValues() // (2) merge field values
+	if err != nil {
+		return err
+	}
 
-	sm.mergeFieldPostings() // (3) merge field postings
+	err = sm.mergeFieldPostings() // (3) merge field postings
+	if err != nil {
+		return err
+	}
 
-	sm.mergeFieldNorms() // (4) merge field norms
+	err = sm.mergeFieldNorms() // (4) merge field norms
+	if err != nil {
+		return err
+	}
 
+	err = sm.mergeVectors() // (5) merge term vectors
+	if err != nil {
+		return err
+	}
+
+	return sm.mergeDocValues() // (6) merge doc values
+}
+
+// mergeDocValues merge the doc values columns of every field
+func (sm *SegmentMerger) mergeDocValues() error {
+	for _, fi := range sm.fieldInfos.byNumber {
+		if fi.docValuesType == DocValuesNone {
+			continue
+		}
+		err := sm.checkProgress()
+		if err != nil {
+			return err
+		}
+		columns := []*docValuesColumn{}
+		sizes := []int64{}
+		for _, r := range sm.readers {
+			column, err := r.docValuesColumn(fi.name, fi.docValuesType)
+			if err != nil {
+				return err
+			}
+			if column != nil && r.deletedDocs != nil {
+				column = column.withoutDeleted(r.deletedDocs)
+			}
+			columns = append(columns, column)
+			sizes = append(sizes, r.numDocs())
+		}
+		merged := mergeDocValues(fi.docValuesType, columns, sizes)
+		err = writeDocValues(docValuesPath(sm.dirPath, sm.name, fi.number), merged)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -275,18 +585,81 @@ func (sm *SegmentMerger) mergeFieldNames() error {
 // MergeFieldValues merge field values
 func (sm *SegmentMerger) mergeFieldValues() error {
 	fw := FieldsWriter{}
-	fw.init(sm.dirPath, sm.name, sm.fieldInfos)
+	err := fw.init(sm.dirPath, sm.name, sm.fieldInfos, sm.config)
+	if err != nil {
+		fw.abort()
+		return err
+	}
 	for _, r := range sm.readers {
 		maxDoc := r.maxDoc()
 		i := int64(0)
 		for i < maxDoc {
-			doc, _ := r.fieldsReader.doc(i)
-			fw.addDocument(doc)
+			err = sm.checkProgress()
+			if err != nil {
+				fw.abort()
+				return err
+			}
+			if r.isDeleted(i) {
+				i = i + 1
+				continue
+			}
+			doc, err := r.fieldsReader.doc(i)
+			if err != nil {
+				fw.abort()
+				return fmt.Errorf("merge stored fields of document %d of segment %s: %v", i, r.seg.name, err)
+			}
+			err = fw.addDocument(doc)
+			if err != nil {
+				fw.abort()
+				return err
+			}
 			i = i + 1
 		}
 	}
-	fw.Close()
-	return nil
+	return fw.Close()
+}
+
+// mergeVectors copy the term vectors of every document
+func (sm *SegmentMerger) mergeVectors() error {
+	if !sm.fieldInfos.hasVectors() {
+		return nil
+	}
+	tw := new(TermVectorsWriter)
+	err := tw.init(sm.dirPath, sm.name, sm.fieldInfos)
+	if err != nil {
+		tw.close()
+		return err
+	}
+	for _, r := range sm.readers {
+		maxDoc := r.maxDoc()
+		i := int64(0)
+		for i < maxDoc {
+			err = sm.checkProgress()
+			if err != nil {
+				tw.close()
+				return err
+			}
+			if r.isDeleted(i) {
+				i = i + 1
+				continue
+			}
+			vectors := []*TermFreqVector{}
+			if r.tvReader != nil {
+				vectors, err = r.tvReader.docVectors(i)
+				if err != nil {
+					tw.close()
+					return err
+				}
+			}
+			err = tw.addDocument(vectors)
+			if err != nil {
+				tw.close()
+				return err
+			}
+			i = i + 1
+		}
+	}
+	return tw.close()
 }
 
 // mergeFieldPostings merge field postings
@@ -300,83 +673,115 @@ func (sm *SegmentMerger) mergeFieldPostings() error {
 	if err != nil {
 		return err
 	}
+	defer frqPtr.close()
 
 	filePath = path.Join(sm.dirPath, sm.name+FileSuffix["termPositions"])
 	prxPtr, err := CreateFile(filePath, false, false)
 	if err != nil {
 		return err
 	}
+	defer prxPtr.close()
 
 	tw := new(TermsWriter)
-	tw.init(sm.dirPath, sm.name, sm.fieldInfos)
+	err = tw.init(sm.dirPath, sm.name, sm.fieldInfos, sm.config.IndexInterval)
+	if err != nil {
+		return err
+	}
 	sm.tw = tw
 
-	sm.mergeTermInfos(frqPtr, prxPtr)
-
-	// file close
-	tw.close()
-	frqPtr.close()
-	prxPtr.close()
-
-	return nil
+	err = sm.mergeTermInfos(frqPtr, prxPtr)
+	if e := tw.close(); err == nil {
+		err = e
+	}
+	return err
 }
 
-// mergeTermInfos merge term infos
+// mergeTermInfos merge the terms of the segments in term order,
+// the segments holding the same term in segment order
 func (sm *SegmentMerger) mergeTermInfos(frqPtr *File, prxPtr *File) error {
 
-	// queue := make(PriorityQueue, len(sm.readers))
 	queue := make(PriorityQueue, 0)
 	base := int64(0)
-	for _, r := range sm.readers {
+	for k, r := range sm.readers {
 		termsPtr, _ := r.termsReader.terms()
 		// add every term
 		for i, term := range termsPtr.terms {
 			smi := new(SegmentMergeInfo)
 			smi.init(base, term, termsPtr.termInfos[i], r)
-			queue.Push(smi)
+			smi.docMap = sm.docMaps[k]
+			heap.Push(&queue, smi)
 		}
 
-		base = base + r.numDocs()
+		base = base + r.maxDoc()
 
 	}
 
 	// reduce
-	match := []*SegmentMergeInfo{}
-
 	for queue.Len() > 0 {
-		matchSize := 0
-		smiPtr, _ := queue.Pop().(*SegmentMergeInfo)
+		match := []*SegmentMergeInfo{}
+		smiPtr, _ := heap.Pop(&queue).(*SegmentMergeInfo)
 		match = append(match, smiPtr)
-		matchSize = matchSize + 1
 
 		termPtr := match[0].term
 		top, _ := queue.Top().(*SegmentMergeInfo)
 
 		for top != nil && termPtr.compare(*top.term) == 0 {
-			smiPtr, _ := queue.Pop().(*SegmentMergeInfo)
+			smiPtr, _ := heap.Pop(&queue).(*SegmentMergeInfo)
 			match = append(match, smiPtr)
-			matchSize = matchSize + 1
 			top, _ = queue.Top().(*SegmentMergeInfo)
 		}
 
-		sm.mergeTermInfo(match, matchSize)
-
-		// for _, smiPtr := range match {
-		// 	queue.Push(smiPtr) // restore queue
-		// }
-
+		err := sm.checkProgress()
+		if err != nil {
+			return err
+		}
+		err = sm.mergeTermInfo(match, frqPtr, prxPtr)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
-// mergeTermInfo merge every term
-func (sm *SegmentMerger) mergeTermInfo(match []*SegmentMergeInfo, matchSize int) error {
+// mergeTermInfo merge the postings of a term in the segments of match,
+// renumbering the documents of each segment from its base
+func (sm *SegmentMerger) mergeTermInfo(match []*SegmentMergeInfo, frqPtr *File, prxPtr *File) error {
+	frqSize, err := frqPtr.getSize()
+	if err != nil {
+		return err
+	}
+	prxSize, err := prxPtr.getSize()
+	if err != nil {
+		return err
+	}
+
+	docFrq := int64(0)
+	lastDoc := int64(0)
 	for _, smi := range match {
-		term := smi.term
-		termInfo := smi.termInfo
-		sm.tw.addTerm(*term, *termInfo)
+		termDocs, err := smi.reader.readPostings(smi.termInfo)
+		if err != nil {
+			return err
+		}
+		live := termDocs[:0]
+		for _, td := range termDocs {
+			if smi.docMap[td.Doc] >= 0 {
+				td.Doc = smi.docMap[td.Doc]
+				live = append(live, td)
+			}
+		}
+		lastDoc, err = writePostings(frqPtr, prxPtr, live, 0, lastDoc)
+		if err != nil {
+			return err
+		}
+		docFrq = docFrq + int64(len(live))
 	}
-	return nil
+	if docFrq == 0 { // only in deleted documents
+		return nil
+	}
+
+	ti := TermInfo{}
+	ti.Init(docFrq, frqSize, prxSize)
+	return sm.tw.addTerm(*match[0].term, ti)
 }
 
 // mergeFieldNorms merge field norms
@@ -384,26 +789,42 @@ func (sm *SegmentMerger) mergeFieldNorms() error {
 
 	for i, fi := range sm.fieldInfos.byNumber {
 		if fi.isIndexed {
-			filePath := path.Join(sm.dirPath, sm.name+FileSuffix["norms"]+strconv.FormatInt(int64(i), 10))
-			nfPtr, err := CreateFile(filePath, false, false)
+			err := sm.checkProgress()
 			if err != nil {
 				return err
 			}
-			// reader
+			// norm of each live document, 0 for the documents of a segment without the field
+			norms := []byte{}
 			for _, reader := range sm.readers {
-				fPtr, _ := reader.normStream(fi.name)
-				maxDoc := reader.maxDoc()
-				k := 0
-				// write norm
-				for k < int(maxDoc) {
-					b, _ := fPtr.readByte()
-					nfPtr.writeByte(b)
+				b, err := reader.normBytes(fi.name)
+				if err != nil {
+					return err
+				}
+				k := int64(0)
+				for k < reader.maxDoc() {
+					if !reader.isDeleted(k) {
+						if b == nil {
+							norms = append(norms, 0)
+						} else {
+							norms = append(norms, b[k])
+						}
+					}
 					k = k + 1
 				}
-				fPtr.close()
 			}
-			// close nfPtr
-			nfPtr.close()
+
+			filePath := path.Join(sm.dirPath, sm.name+FileSuffix["norms"]+strconv.FormatInt(int64(i), 10))
+			nfPtr, err := CreateFile(filePath, false, false)
+			if err != nil {
+				return err
+			}
+			_, err = nfPtr.file.Write(norms)
+			if e := nfPtr.close(); err == nil {
+				err = e
+			}
+			if err != nil {
+				return err
+			}
 		}
 	}
 	return nil
@@ -0,0 +1,160 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+/*
+A ShingleFilter joins neighboring tokens into shingles, word n-grams of minSize to maxSize tokens,
+e.g. "明月 光" out of 明月 and 光.
+
+Indexing shingles lets a frequent phrase be answered by a single term lookup
+instead of intersecting the positions of its words.
+Shingles are stacked at the position of their first token,
+their position length is the number of tokens joined,
+and their offsets span from the first to the last token.
+With outputUnigrams the tokens themselves are emitted too, before the shingles starting at them.
+
+A position gap, e.g. left by a removed stop word, is filled with the filler token "_",
+shingles never start or end with a filler.
+The input is expected to be flat, a stacked token is joined like the next position.
+*/
+
+// ShingleFilter shingle filter
+type ShingleFilter struct {
+	TokenFilter
+	minSize        int
+	maxSize        int
+	separator      string   // between the tokens of a shingle
+	outputUnigrams bool     // also emit the tokens themselves
+	window         []*Token // upcoming tokens, nil for a filler
+	emitted        []*Token // tokens ready to be returned
+	pendingInc     int64    // positions since the last emitted position
+	exhausted      bool
+}
+
+// ShingleFillerToken text standing for an empty position inside a shingle
+const ShingleFillerToken = "_"
+
+// ShingleTokenType type of a shingle token
+const ShingleTokenType = "shingle"
+
+// NewShingleFilter new shingle filter
+func NewShingleFilter(input TokenStream, minSize, maxSize int, separator string, outputUnigrams bool) (*ShingleFilter, error) {
+	err := checkShingleSizes(minSize, maxSize)
+	if err != nil {
+		return nil, err
+	}
+	return &ShingleFilter{
+		TokenFilter:    TokenFilter{input: input},
+		minSize:        minSize,
+		maxSize:        maxSize,
+		separator:      separator,
+		outputUnigrams: outputUnigrams,
+	}, nil
+}
+
+// ShingleFilterFactory shingle filter for an analyzer chain
+func ShingleFilterFactory(minSize, maxSize int, separator string, outputUnigrams bool) (TokenFilterFactory, error) {
+	err := checkShingleSizes(minSize, maxSize)
+	if err != nil {
+		return nil, err
+	}
+	return func(input TokenStream) TokenStream {
+		sf, _ := NewShingleFilter(input, minSize, maxSize, separator, outputUnigrams)
+		return sf
+	}, nil
+}
+
+// checkShingleSizes shingles join at least two tokens
+func checkShingleSizes(minSize, maxSize int) error {
+	if minSize < 2 {
+		return fmt.Errorf("minShingleSize must be at least 2")
+	}
+	if minSize > maxSize {
+		return fmt.Errorf("minShingleSize must not be greater than maxShingleSize")
+	}
+	return nil
+}
+
+// ================================ShingleFilter=======================================
+
+// Next next token or shingle
+func (sf *ShingleFilter) Next() (*Token, error) {
+	for len(sf.emitted) == 0 {
+		err := sf.fill()
+		if err != nil {
+			return nil, err
+		}
+		if len(sf.window) == 0 {
+			return nil, nil
+		}
+		sf.shingles()
+	}
+
+	t := sf.emitted[0]
+	sf.emitted = sf.emitted[1:]
+	return t, nil
+}
+
+// fill read tokens until the window holds maxSize positions
+func (sf *ShingleFilter) fill() error {
+	for len(sf.window) < sf.maxSize && !sf.exhausted {
+		t, err := sf.input.Next()
+		if err != nil {
+			return err
+		}
+		if t == nil {
+			sf.exhausted = true
+			break
+		}
+		for k := int64(1); k < t.PositionIncrement; k++ {
+			sf.window = append(sf.window, nil)
+		}
+		sf.window = append(sf.window, t)
+	}
+	return nil
+}
+
+// shingles emit the tokens starting at the first position of the window
+func (sf *ShingleFilter) shingles() {
+	head := sf.window[0]
+	sf.pendingInc = sf.pendingInc + 1
+
+	if head != nil {
+		if sf.outputUnigrams {
+			u := *head
+			u.PositionLength = 1
+			sf.emitted = append(sf.emitted, &u)
+		}
+		for n := sf.minSize; n <= sf.maxSize && n <= len(sf.window); n++ {
+			last := sf.window[n-1]
+			if last == nil {
+				continue
+			}
+			texts := make([]string, n)
+			for i, t := range sf.window[:n] {
+				if t == nil {
+					texts[i] = ShingleFillerToken
+				} else {
+					texts[i] = t.TermText
+				}
+			}
+			s := newToken(strings.Join(texts, sf.separator), head.StartOffset, last.EndOffset, ShingleTokenType)
+			s.PositionLength = int64(n)
+			sf.emitted = append(sf.emitted, s)
+		}
+	}
+
+	for i, t := range sf.emitted {
+		t.PositionIncrement = 0
+		if i == 0 {
+			t.PositionIncrement = sf.pendingInc
+		}
+	}
+	if len(sf.emitted) > 0 {
+		sf.pendingInc = 0
+	}
+	sf.window = sf.window[1:]
+}
@@ -0,0 +1,129 @@
+package core
+
+import (
+	"bytes"
+	"compress/flate"
+	"fmt"
+	"io"
+)
+
+/*
+Stored fields are written in blocks of documents, each block compressed as a whole by a StoredFieldsCodec,
+so the repeated words of neighbouring poems compress together.
+The codec of a segment is named in its .fdt file, and found again by name when the segment is read,
+a codec other than the builtin ones is registered with RegisterStoredFieldsCodec before its segments are opened.
+
+The deflate codec has a fast mode, for indexing speed, and a high compression mode, for index size,
+larger blocks (StoredFieldsBlockSize) compress better at the cost of decompressing more to read one document.
+*/
+
+// StoredFieldsCodec compression of blocks of stored fields
+type StoredFieldsCodec interface {
+	Name() string
+	Compress(data []byte) ([]byte, error)
+	Decompress(data []byte, length int) ([]byte, error) // length of the uncompressed data
+}
+
+// CompressionMode trade off between speed and size
+type CompressionMode int
+
+const (
+	// CompressionFast fast compression
+	CompressionFast CompressionMode = iota
+	// CompressionHigh high compression
+	CompressionHigh
+)
+
+// DeflateCodec DEFLATE compression of stored fields
+type DeflateCodec struct {
+	mode CompressionMode
+}
+
+// NoCompressionCodec stored fields kept as they are
+type NoCompressionCodec struct {
+}
+
+var storedFieldsCodecs = map[string]StoredFieldsCodec{
+	"deflate": NewDeflateCodec(CompressionFast),
+	"none":    NoCompressionCodec{},
+}
+
+// NewDeflateCodec deflate codec of a mode
+func NewDeflateCodec(mode CompressionMode) *DeflateCodec {
+	return &DeflateCodec{mode: mode}
+}
+
+// RegisterStoredFieldsCodec make a codec known by its name, to read its segments
+func RegisterStoredFieldsCodec(codec StoredFieldsCodec) {
+	storedFieldsCodecs[codec.Name()] = codec
+}
+
+// storedFieldsCodec codec of a name
+func storedFieldsCodec(name string) (StoredFieldsCodec, error) {
+	codec, found := storedFieldsCodecs[name]
+	if !found {
+		return nil, fmt.Errorf("unknown stored fields codec %s", name)
+	}
+	return codec, nil
+}
+
+// ================================DeflateCodec=======================================
+
+// Name deflate, both modes read the same
+func (dc *DeflateCodec) Name() string {
+	return "deflate"
+}
+
+// Compress deflate data
+func (dc *DeflateCodec) Compress(data []byte) ([]byte, error) {
+	level := flate.BestSpeed
+	if dc.mode == CompressionHigh {
+		level = flate.BestCompression
+	}
+	var b bytes.Buffer
+	w, err := flate.NewWriter(&b, level)
+	if err != nil {
+		return nil, err
+	}
+	_, err = w.Write(data)
+	if err != nil {
+		return nil, err
+	}
+	err = w.Close()
+	if err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// Decompress inflate data
+func (dc *DeflateCodec) Decompress(data []byte, length int) ([]byte, error) {
+	r := flate.NewReader(bytes.NewReader(data))
+	defer r.Close()
+	b := make([]byte, length)
+	_, err := io.ReadFull(r, b)
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// ================================NoCompressionCodec=======================================
+
+// Name none
+func (nc NoCompressionCodec) Name() string {
+	return "none"
+}
+
+// Compress data as it is
+func (nc NoCompressionCodec) Compress(data []byte) ([]byte, error) {
+	return data, nil
+}
+
+// Decompress data as it is
+func (nc NoCompressionCodec) Decompress(data []byte, length int) ([]byte, error) {
+	if len(data) != length {
+		return nil, fmt.Errorf("stored block of %d bytes, want %d", len(data), length)
+	}
+	return data, nil
+}
@@ -0,0 +1,593 @@
+package core
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+/*
+An HTMLStripCharFilter removes HTML markup before tokenization.
+
+Tags, comments, processing instructions and doctypes are removed,
+script and style elements are removed with their content,
+block level elements such as <p> or <br> become a line break so words on both sides stay apart,
+CDATA sections keep their content,
+and character entities (&amp;, &#39;, &#x4E00;, ...) are decoded.
+
+Every removal and replacement is recorded,
+so token offsets point into the original markup,
+which is what highlighting against the stored source needs.
+The input of a single field value is read at once.
+*/
+
+// HTMLStripCharFilter html strip char filter
+type HTMLStripCharFilter struct {
+	stripBuilder
+}
+
+/*
+A MarkdownStripCharFilter removes Markdown markup before tokenization.
+
+Heading, blockquote and list markers, horizontal rules, code fences and link definitions are removed,
+links and images keep their text, emphasis and code markers are dropped,
+and backslash escapes keep the escaped character.
+Inline HTML is left alone, chain an HTMLStripCharFilter for it.
+Like the HTML filter it records every change for offset correction.
+*/
+
+// MarkdownStripCharFilter markdown strip char filter
+type MarkdownStripCharFilter struct {
+	stripBuilder
+	dropAt map[int]int // input start -> end of markup to drop when reached
+}
+
+// stripBuilder output of a strip char filter with its offset corrections
+type stripBuilder struct {
+	offsetCorrector
+	out      bytes.Buffer
+	in       int64           // input bytes consumed
+	stripped *bytes.Reader   // output, once the input is stripped
+	removals map[int64]int64 // output offset of a removal after a word -> difference before it
+	strip    func(text string)
+}
+
+// htmlBlockTags elements that separate words
+var htmlBlockTags = map[string]bool{
+	"address": true, "article": true, "aside": true, "blockquote": true, "br": true,
+	"dd": true, "div": true, "dl": true, "dt": true, "fieldset": true,
+	"figcaption": true, "figure": true, "footer": true, "form": true, "h1": true,
+	"h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"header": true, "hr": true, "li": true, "main": true, "nav": true,
+	"ol": true, "p": true, "pre": true, "section": true, "table": true,
+	"tbody": true, "td": true, "tfoot": true, "th": true, "thead": true,
+	"tr": true, "ul": true, "title": true, "body": true, "html": true,
+}
+
+// htmlEntities named character entities
+var htmlEntities = map[string]string{
+	"amp": "&", "lt": "<", "gt": ">", "quot": "\"", "apos": "'",
+	"nbsp": " ", "ensp": " ", "emsp": " ", "thinsp": " ",
+	"mdash": "—", "ndash": "–", "hellip": "…", "middot": "·", "bull": "•",
+	"lsquo": "‘", "rsquo": "’", "ldquo": "“", "rdquo": "”", "laquo": "«", "raquo": "»",
+	"copy": "©", "reg": "®", "trade": "™", "deg": "°", "times": "×", "divide": "÷",
+	"sect": "§", "para": "¶", "iexcl": "¡", "iquest": "¿", "shy": "­",
+	"aacute": "á", "agrave": "à", "acirc": "â", "auml": "ä", "atilde": "ã", "aring": "å",
+	"eacute": "é", "egrave": "è", "ecirc": "ê", "euml": "ë",
+	"iacute": "í", "igrave": "ì", "icirc": "î", "iuml": "ï",
+	"oacute": "ó", "ograve": "ò", "ocirc": "ô", "ouml": "ö", "otilde": "õ", "oslash": "ø",
+	"uacute": "ú", "ugrave": "ù", "ucirc": "û", "uuml": "ü",
+	"ccedil": "ç", "ntilde": "ñ", "szlig": "ß", "aelig": "æ", "oelig": "œ",
+}
+
+// NewHTMLStripCharFilter new html strip char filter
+func NewHTMLStripCharFilter(reader io.Reader) *HTMLStripCharFilter {
+	hf := &HTMLStripCharFilter{}
+	hf.input = reader
+	hf.removals = map[int64]int64{}
+	hf.strip = hf.stripHTML
+	return hf
+}
+
+// NewMarkdownStripCharFilter new markdown strip char filter
+func NewMarkdownStripCharFilter(reader io.Reader) *MarkdownStripCharFilter {
+	mf := &MarkdownStripCharFilter{}
+	mf.input = reader
+	mf.removals = map[int64]int64{}
+	mf.strip = mf.stripMarkdown
+	return mf
+}
+
+// ================================stripBuilder=======================================
+
+// Read read stripped text
+func (sb *stripBuilder) Read(p []byte) (int, error) {
+	if sb.stripped == nil {
+		data, err := ioutil.ReadAll(sb.input)
+		if err != nil {
+			return 0, err
+		}
+		sb.strip(string(data))
+		sb.settle()
+		sb.stripped = bytes.NewReader(sb.out.Bytes())
+	}
+	return sb.stripped.Read(p)
+}
+
+// settle move a removal that directly follows a word behind the next character,
+// so the end offset of a token followed by markup points before the markup
+func (sb *stripBuilder) settle() {
+	out := sb.out.Bytes()
+	offsets := []int64{}
+	diffs := []int64{}
+	add := func(off, diff int64) {
+		n := len(offsets)
+		if n > 0 && offsets[n-1] >= off { // the later change takes over
+			offsets = offsets[:n-1]
+			diffs = diffs[:n-1]
+			n = n - 1
+		}
+		if n > 0 && diffs[n-1] == diff || n == 0 && diff == 0 {
+			return
+		}
+		offsets = append(offsets, off)
+		diffs = append(diffs, diff)
+	}
+	for i, off := range sb.offsets {
+		before, removed := sb.removals[off]
+		if removed && (off >= int64(len(out)) || !isASCIIWordByte(out[off])) {
+			add(off, before)
+			add(off+1, sb.diffs[i])
+			continue
+		}
+		add(off, sb.diffs[i])
+	}
+	sb.offsets = offsets
+	sb.diffs = diffs
+}
+
+// copy copy input text unchanged
+func (sb *stripBuilder) copy(text string) {
+	sb.out.WriteString(text)
+	sb.in = sb.in + int64(len(text))
+}
+
+// replace replace inLen input bytes by text
+func (sb *stripBuilder) replace(inLen int, text string) {
+	outStart := int64(sb.out.Len())
+	if text == "" && outStart > 0 && isASCIIWordByte(sb.out.Bytes()[outStart-1]) {
+		if _, found := sb.removals[outStart]; !found {
+			sb.removals[outStart] = sb.currentDiff()
+		}
+	}
+	sb.out.WriteString(text)
+	sb.addReplacement(sb.in, sb.in+int64(inLen), outStart, outStart+int64(len(text)))
+	sb.in = sb.in + int64(inLen)
+}
+
+// ================================HTMLStripCharFilter=======================================
+
+// stripHTML strip markup of text
+func (hf *HTMLStripCharFilter) stripHTML(text string) {
+	i := 0
+	for i < len(text) {
+		next := strings.IndexAny(text[i:], "<&")
+		if next < 0 {
+			hf.copy(text[i:])
+			return
+		}
+		hf.copy(text[i : i+next])
+		i = i + next
+
+		var n int
+		if text[i] == '<' {
+			n = hf.markup(text[i:])
+		} else {
+			n = hf.entity(text[i:])
+		}
+		if n == 0 { // not markup, keep the character
+			hf.copy(text[i : i+1])
+			n = 1
+		}
+		i = i + n
+	}
+}
+
+// markup strip the markup at the start of s, return its length or zero
+func (hf *HTMLStripCharFilter) markup(s string) int {
+	switch {
+	case strings.HasPrefix(s, "<!--"):
+		return hf.removeThrough(s, "-->", "")
+	case strings.HasPrefix(s, "<![CDATA["):
+		end := strings.Index(s, "]]>")
+		if end < 0 {
+			return 0
+		}
+		hf.replace(len("<![CDATA["), "")
+		hf.copy(s[len("<![CDATA["):end])
+		hf.replace(len("]]>"), "")
+		return end + len("]]>")
+	case strings.HasPrefix(s, "<!"), strings.HasPrefix(s, "<?"):
+		return hf.removeThrough(s, ">", "")
+	}
+
+	name, closing, end := parseTag(s)
+	if end == 0 {
+		return 0
+	}
+	if !closing && (name == "script" || name == "style") { // drop the whole element
+		closeTag := indexCloseTag(s, name)
+		if closeTag < 0 {
+			hf.replace(len(s), "")
+			return len(s)
+		}
+		_, _, closeEnd := parseTag(s[closeTag:])
+		if closeEnd == 0 {
+			closeEnd = len(s) - closeTag
+		}
+		hf.replace(closeTag+closeEnd, "\n")
+		return closeTag + closeEnd
+	}
+
+	replacement := ""
+	if htmlBlockTags[name] {
+		replacement = "\n"
+	}
+	hf.replace(end, replacement)
+	return end
+}
+
+// indexCloseTag index of the first closing tag of name in s, ignoring the case of the name, or -1
+func indexCloseTag(s string, name string) int {
+	for i := 0; i+2+len(name) <= len(s); {
+		next := strings.Index(s[i:], "</")
+		if next < 0 {
+			return -1
+		}
+		i = i + next
+		if i+2+len(name) <= len(s) && strings.EqualFold(s[i+2:i+2+len(name)], name) {
+			return i
+		}
+		i = i + 2
+	}
+	return -1
+}
+
+// removeThrough remove s up to and including terminator
+func (hf *HTMLStripCharFilter) removeThrough(s string, terminator string, replacement string) int {
+	end := strings.Index(s, terminator)
+	if end < 0 {
+		end = len(s)
+	} else {
+		end = end + len(terminator)
+	}
+	hf.replace(end, replacement)
+	return end
+}
+
+// entity decode the character entity at the start of s, return its length or zero
+func (hf *HTMLStripCharFilter) entity(s string) int {
+	semi := strings.IndexByte(s, ';')
+	if semi < 2 || semi > 10 {
+		return 0
+	}
+	name := s[1:semi]
+
+	var value string
+	if name[0] == '#' {
+		var (
+			code int64
+			err  error
+		)
+		if len(name) > 1 && (name[1] == 'x' || name[1] == 'X') {
+			code, err = strconv.ParseInt(name[2:], 16, 32)
+		} else {
+			code, err = strconv.ParseInt(name[1:], 10, 32)
+		}
+		if err != nil || !utf8.ValidRune(rune(code)) {
+			return 0
+		}
+		value = string(rune(code))
+	} else {
+		v, found := htmlEntities[strings.ToLower(name)]
+		if !found {
+			return 0
+		}
+		value = v
+	}
+	hf.replace(semi+1, value)
+	return semi + 1
+}
+
+// parseTag name of the tag at the start of s, whether it is a closing tag, and its length
+func parseTag(s string) (string, bool, int) {
+	i := 1
+	closing := false
+	if i < len(s) && s[i] == '/' {
+		closing = true
+		i = i + 1
+	}
+	start := i
+	for i < len(s) && (isASCIILetter(s[i]) || i > start && (s[i] >= '0' && s[i] <= '9' || s[i] == '-' || s[i] == ':')) {
+		i = i + 1
+	}
+	if i == start {
+		return "", false, 0
+	}
+	name := strings.ToLower(s[start:i])
+
+	var quote byte
+	for i < len(s) {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '>':
+			return name, closing, i + 1
+		case c == '<': // not a tag after all
+			return "", false, 0
+		}
+		i = i + 1
+	}
+	return "", false, 0
+}
+
+// isASCIILetter a-z or A-Z
+func isASCIILetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// ================================MarkdownStripCharFilter=======================================
+
+// stripMarkdown strip markup of text, line by line
+func (mf *MarkdownStripCharFilter) stripMarkdown(text string) {
+	mf.dropAt = map[int]int{}
+	inFence := ""
+	for len(text) > 0 {
+		end := strings.IndexByte(text, '\n')
+		if end < 0 {
+			end = len(text)
+		} else {
+			end = end + 1 // keep the line break with the line
+		}
+		line := text[:end]
+		text = text[end:]
+
+		content := strings.TrimRight(line, "\r\n")
+		trimmed := strings.TrimLeft(content, " ")
+
+		// code fences, code inside is kept as is
+		if fence := fenceMarker(trimmed); fence != "" && (inFence == "" || strings.HasPrefix(fence, inFence)) {
+			if inFence == "" {
+				inFence = fence
+			} else {
+				inFence = ""
+			}
+			mf.replace(len(content), "")
+			mf.copy(line[len(content):])
+			continue
+		}
+		if inFence != "" {
+			mf.copy(line)
+			continue
+		}
+
+		prefix := len(content) - len(trimmed) + markdownPrefix(trimmed)
+		if isHorizontalRule(trimmed) || isLinkDefinition(content[prefix:]) { // also a definition in a list or quote
+			mf.replace(len(content), "")
+			mf.copy(line[len(content):])
+			continue
+		}
+
+		if prefix > 0 {
+			mf.replace(prefix, "")
+		}
+		mf.inline(content[prefix:])
+		mf.copy(line[len(content):])
+	}
+}
+
+// inline strip inline markup of a line
+func (mf *MarkdownStripCharFilter) inline(s string) {
+	base := int(mf.in) // input offset of s
+	i := 0
+	for i < len(s) {
+		if end, found := mf.dropAt[base+i]; found {
+			mf.replace(end-(base+i), "")
+			i = end - base
+			continue
+		}
+
+		c := s[i]
+		switch {
+		case c == '\\' && i+1 < len(s) && strings.IndexByte("\\`*_{}[]()#+-.!~<>|", s[i+1]) >= 0:
+			mf.replace(1, "")
+			mf.copy(s[i+1 : i+2])
+			i = i + 2
+
+		case c == '`':
+			n := runLength(s[i:], '`')
+			mf.replace(n, "")
+			i = i + n
+
+		case c == '*' || c == '~' && strings.HasPrefix(s[i:], "~~"):
+			n := runLength(s[i:], c)
+			mf.replace(n, "")
+			i = i + n
+
+		case c == '_' && isWordBoundary(s, i, runLength(s[i:], '_')):
+			n := runLength(s[i:], '_')
+			mf.replace(n, "")
+			i = i + n
+
+		case c == '!' && strings.HasPrefix(s[i:], "!["), c == '[':
+			open := 1
+			if c == '!' {
+				open = 2
+			}
+			closeBracket, linkEnd := parseLink(s[i+open-1:])
+			if linkEnd == 0 {
+				mf.copy(s[i : i+1])
+				i = i + 1
+				continue
+			}
+			// drop the opening bracket now and the target when the text is done
+			closeAt := base + i + open - 1 + closeBracket
+			mf.dropAt[closeAt] = base + i + open - 1 + linkEnd
+			mf.replace(open, "")
+			i = i + open
+
+		case c == '<' && isAutolink(s[i:]):
+			end := strings.IndexByte(s[i:], '>')
+			mf.replace(1, "")
+			mf.copy(s[i+1 : i+end])
+			mf.replace(1, "")
+			i = i + end + 1
+
+		default:
+			_, size := utf8.DecodeRuneInString(s[i:])
+			mf.copy(s[i : i+size])
+			i = i + size
+		}
+	}
+}
+
+// fenceMarker the ``` or ~~~ run opening a line, if any
+func fenceMarker(line string) string {
+	for _, c := range []byte{'`', '~'} {
+		if n := runLength(line, c); n >= 3 {
+			return line[:n]
+		}
+	}
+	return ""
+}
+
+// isHorizontalRule three or more -, * or _ and nothing else
+func isHorizontalRule(line string) bool {
+	compact := strings.Replace(strings.TrimSpace(line), " ", "", -1)
+	if len(compact) < 3 {
+		return false
+	}
+	for _, c := range []byte{'-', '*', '_', '='} {
+		if runLength(compact, c) == len(compact) {
+			return true
+		}
+	}
+	return false
+}
+
+// isLinkDefinition [ref]: url
+func isLinkDefinition(line string) bool {
+	if !strings.HasPrefix(line, "[") {
+		return false
+	}
+	end := strings.Index(line, "]:")
+	return end > 1 && !strings.ContainsAny(line[1:end], "[]")
+}
+
+// markdownPrefix length of blockquote, heading and list markers opening a line
+func markdownPrefix(line string) int {
+	i := 0
+	for {
+		rest := line[i:]
+		switch {
+		case strings.HasPrefix(rest, ">"):
+			i = i + 1
+		case strings.HasPrefix(rest, "#"):
+			n := runLength(rest, '#')
+			if n > 6 || n < len(rest) && rest[n] != ' ' {
+				return i
+			}
+			i = i + n
+		case len(rest) > 1 && strings.IndexByte("-*+", rest[0]) >= 0 && rest[1] == ' ':
+			i = i + 1
+		default:
+			digits := 0
+			for digits < len(rest) && rest[digits] >= '0' && rest[digits] <= '9' {
+				digits = digits + 1
+			}
+			if digits == 0 || digits+1 >= len(rest) || (rest[digits] != '.' && rest[digits] != ')') || rest[digits+1] != ' ' {
+				return i
+			}
+			i = i + digits + 1
+		}
+		for i < len(line) && line[i] == ' ' {
+			i = i + 1
+		}
+	}
+}
+
+// parseLink offsets of the closing bracket and the end of [text](url) or [text][ref]
+func parseLink(s string) (int, int) {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i = i + 1
+		case '[':
+			depth = depth + 1
+		case ']':
+			depth = depth - 1
+			if depth > 0 {
+				continue
+			}
+			rest := s[i+1:]
+			var closer byte
+			switch {
+			case strings.HasPrefix(rest, "("):
+				closer = ')'
+			case strings.HasPrefix(rest, "["):
+				closer = ']'
+			default:
+				return 0, 0
+			}
+			end := strings.IndexByte(rest, closer)
+			if end < 0 {
+				return 0, 0
+			}
+			return i, i + 1 + end + 1
+		}
+	}
+	return 0, 0
+}
+
+// isAutolink <scheme://...> or <user@host>
+func isAutolink(s string) bool {
+	end := strings.IndexByte(s, '>')
+	if end < 0 {
+		return false
+	}
+	inner := s[1:end]
+	if strings.ContainsAny(inner, " <") {
+		return false
+	}
+	return strings.Contains(inner, "://") || strings.Contains(inner, "@")
+}
+
+// runLength number of c at the start of s
+func runLength(s string, c byte) int {
+	n := 0
+	for n < len(s) && s[n] == c {
+		n = n + 1
+	}
+	return n
+}
+
+// isWordBoundary the run s[i:i+n] is not inside a word, like snake_case
+func isWordBoundary(s string, i int, n int) bool {
+	before := i == 0 || !isASCIIWordByte(s[i-1])
+	after := i+n >= len(s) || !isASCIIWordByte(s[i+n])
+	return before || after
+}
+
+// isASCIIWordByte letter or digit
+func isASCIIWordByte(c byte) bool {
+	return isASCIILetter(c) || c >= '0' && c <= '9' || c >= utf8.RuneSelf
+}
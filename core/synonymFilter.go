@@ -0,0 +1,479 @@
+package core
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+)
+
+/*
+A SynonymMap maps word sequences to the word sequences that may replace them.
+
+Entries are analyzed with the analyzer the map is built with,
+so "长安" with a standard analyzer is the two words "长" "安".
+That analyzer should match the tokenizer and filters in front of the SynonymFilter.
+
+Two file formats are read:
+
+Solr format, one rule per line, '#' starts a comment:
+	长安, 京城, 西京      equivalent words
+	洛阳 => 东都          explicit mapping, the original is replaced
+Commas inside a word are escaped as "\,".
+
+WordNet prolog format, words sharing a synset id are equivalent:
+	s(100000001,1,'长安',n,1,0).
+	s(100000001,2,'京城',n,1,0).
+
+When expand is true, each of a set of equivalent words maps to all the others,
+otherwise all of them map to the first word.
+*/
+
+// SynonymMap synonym map
+type SynonymMap struct {
+	analyzer Analyzer                // splits entries into words
+	expand   bool                    // expand equivalent words
+	rules    map[string]*synonymRule // input words -> rule
+	prefixes map[string]bool         // every proper prefix of an input
+	maxInput int                     // longest input, in words
+}
+
+// synonymRule outputs of one input
+type synonymRule struct {
+	outputs  [][]string // word sequences replacing the input
+	keepOrig bool       // also keep the input itself
+}
+
+/*
+A SynonymFilter matches the longest input of a SynonymMap at each position
+and emits the synonyms as a token graph.
+
+Every path through the graph starts at the first matched position and ends after the last one.
+The original words (when kept) and each synonym are separate paths,
+tokens of a multi-word path get their own intermediate positions,
+and a token that is shorter than the span it replaces gets a position length greater than one.
+For example 长安 => 京城 keeping the original yields
+
+	长(inc 1, len 1) 京(inc 0, len 2) 安(inc 1, len 2) 城(inc 1, len 1)
+
+so the paths 长安 and 京城 both run from the first position to the fourth.
+
+Query-time consumers may use the graph as is,
+the index does not store position lengths,
+so an index-time chain must follow the SynonymFilter with a FlattenGraphFilter.
+*/
+
+// SynonymFilter synonym graph filter
+type SynonymFilter struct {
+	TokenFilter
+	synonyms  *SynonymMap
+	lookahead []*Token // tokens read but not yet matched
+	emitted   []*Token // tokens ready to be returned
+	exhausted bool
+}
+
+// synonymSeparator joins the words of a rule key
+const synonymSeparator = "\x00"
+
+// NewSynonymMap new synonym map
+func NewSynonymMap(analyzer Analyzer, expand bool) *SynonymMap {
+	return &SynonymMap{
+		analyzer: analyzer,
+		expand:   expand,
+		rules:    map[string]*synonymRule{},
+		prefixes: map[string]bool{},
+	}
+}
+
+// NewSynonymFilter new synonym filter
+func NewSynonymFilter(input TokenStream, synonyms *SynonymMap) *SynonymFilter {
+	return &SynonymFilter{
+		TokenFilter: TokenFilter{input: input},
+		synonyms:    synonyms,
+	}
+}
+
+// LoadSynonymFile read a synonym file, format is "solr" or "wordnet"
+func LoadSynonymFile(filePath string, format string, analyzer Analyzer, expand bool) (*SynonymMap, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	sm := NewSynonymMap(analyzer, expand)
+	switch format {
+	case "solr", "":
+		err = sm.ReadSolr(f)
+	case "wordnet":
+		err = sm.ReadWordNet(f)
+	default:
+		err = fmt.Errorf("unknown synonym format: %s", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sm, nil
+}
+
+// ================================SynonymMap=======================================
+
+// Add add a mapping from input to output
+func (sm *SynonymMap) Add(input string, output string, includeOrig bool) error {
+	in, err := sm.analyze(input)
+	if err != nil {
+		return err
+	}
+	out, err := sm.analyze(output)
+	if err != nil {
+		return err
+	}
+	if len(in) == 0 || len(out) == 0 {
+		return fmt.Errorf("synonym entry analyzed to no words: %q => %q", input, output)
+	}
+
+	key := strings.Join(in, synonymSeparator)
+	rule, found := sm.rules[key]
+	if !found {
+		rule = &synonymRule{}
+		sm.rules[key] = rule
+	}
+	rule.keepOrig = rule.keepOrig || includeOrig
+
+	outKey := strings.Join(out, synonymSeparator)
+	for _, o := range rule.outputs { // dedup
+		if strings.Join(o, synonymSeparator) == outKey {
+			return nil
+		}
+	}
+	rule.outputs = append(rule.outputs, out)
+
+	for i := 1; i < len(in); i++ {
+		sm.prefixes[strings.Join(in[:i], synonymSeparator)] = true
+	}
+	if len(in) > sm.maxInput {
+		sm.maxInput = len(in)
+	}
+	return nil
+}
+
+// addEquivalent add a set of equivalent words
+func (sm *SynonymMap) addEquivalent(words []string) error {
+	var err error
+	if sm.expand {
+		for i := range words {
+			for j := range words {
+				if i != j {
+					err = sm.Add(words[i], words[j], true)
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	}
+	for i := range words {
+		err = sm.Add(words[i], words[0], false)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadSolr read solr format rules
+func (sm *SynonymMap) ReadSolr(reader io.Reader) error {
+	scanner := bufio.NewScanner(reader)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber = lineNumber + 1
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		sides := strings.Split(line, "=>")
+		if len(sides) > 2 {
+			return fmt.Errorf("more than one explicit mapping on line %d", lineNumber)
+		}
+
+		if len(sides) == 2 { // explicit mapping
+			inputs := splitSynonyms(sides[0])
+			outputs := splitSynonyms(sides[1])
+			for _, in := range inputs {
+				for _, out := range outputs {
+					err := sm.Add(in, out, false)
+					if err != nil {
+						return fmt.Errorf("line %d: %v", lineNumber, err)
+					}
+				}
+			}
+			continue
+		}
+
+		err := sm.addEquivalent(splitSynonyms(line))
+		if err != nil {
+			return fmt.Errorf("line %d: %v", lineNumber, err)
+		}
+	}
+	return scanner.Err()
+}
+
+// ReadWordNet read wordnet prolog format rules
+func (sm *SynonymMap) ReadWordNet(reader io.Reader) error {
+	var (
+		lastSynset string
+		words      []string
+		err        error
+	)
+	scanner := bufio.NewScanner(reader)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber = lineNumber + 1
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 {
+			continue
+		}
+
+		synset, word, err := parseWordNetLine(line)
+		if err != nil {
+			return fmt.Errorf("line %d: %v", lineNumber, err)
+		}
+		if synset != lastSynset && len(words) > 0 {
+			err = sm.addEquivalent(words)
+			if err != nil {
+				return fmt.Errorf("line %d: %v", lineNumber, err)
+			}
+			words = words[:0]
+		}
+		lastSynset = synset
+		words = append(words, word)
+	}
+	if err = scanner.Err(); err != nil {
+		return err
+	}
+	if len(words) > 0 {
+		return sm.addEquivalent(words)
+	}
+	return nil
+}
+
+// analyze split an entry into words
+func (sm *SynonymMap) analyze(text string) ([]string, error) {
+	tokens, err := TokenSlice(sm.analyzer, "", text)
+	if err != nil {
+		return nil, err
+	}
+	words := []string{}
+	for _, t := range tokens {
+		if t.PositionIncrement != 1 && len(words) > 0 {
+			return nil, fmt.Errorf("synonym entry %q analyzed to a graph", text)
+		}
+		words = append(words, t.TermText)
+	}
+	return words, nil
+}
+
+// lookup rule of the input words
+func (sm *SynonymMap) lookup(key string) *synonymRule {
+	return sm.rules[key]
+}
+
+// splitSynonyms split on unescaped commas
+func splitSynonyms(s string) []string {
+	var (
+		words []string
+		b     strings.Builder
+	)
+	escaped := false
+	for _, r := range s {
+		switch {
+		case escaped:
+			b.WriteRune(r)
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == ',':
+			if w := strings.TrimSpace(b.String()); len(w) > 0 {
+				words = append(words, w)
+			}
+			b.Reset()
+		default:
+			b.WriteRune(r)
+		}
+	}
+	if w := strings.TrimSpace(b.String()); len(w) > 0 {
+		words = append(words, w)
+	}
+	return words
+}
+
+// parseWordNetLine get synset id and word of s(id,num,'word',...).
+func parseWordNetLine(line string) (string, string, error) {
+	if !strings.HasPrefix(line, "s(") {
+		return "", "", fmt.Errorf("not a wordnet s() entry")
+	}
+	line = line[2:]
+	comma := strings.Index(line, ",")
+	if comma < 0 {
+		return "", "", fmt.Errorf("missing synset id")
+	}
+	synset := line[:comma]
+
+	start := strings.Index(line, "'")
+	if start < 0 {
+		return "", "", fmt.Errorf("missing word")
+	}
+	var b strings.Builder
+	i := start + 1
+	for i < len(line) {
+		if line[i] == '\'' {
+			if i+1 < len(line) && line[i+1] == '\'' { // escaped quote
+				b.WriteByte('\'')
+				i = i + 2
+				continue
+			}
+			return synset, b.String(), nil
+		}
+		b.WriteByte(line[i])
+		i = i + 1
+	}
+	return "", "", fmt.Errorf("unterminated word")
+}
+
+// ================================SynonymFilter=======================================
+
+// Next next token
+func (sf *SynonymFilter) Next() (*Token, error) {
+	for len(sf.emitted) == 0 {
+		err := sf.fill()
+		if err != nil {
+			return nil, err
+		}
+		if len(sf.lookahead) == 0 {
+			return nil, nil
+		}
+
+		n, rule := sf.match()
+		if rule == nil {
+			sf.emitted = append(sf.emitted, sf.lookahead[0])
+			sf.lookahead = sf.lookahead[1:]
+			continue
+		}
+		sf.emitGraph(n, rule)
+		sf.lookahead = sf.lookahead[n:]
+	}
+
+	t := sf.emitted[0]
+	sf.emitted = sf.emitted[1:]
+	return t, nil
+}
+
+// fill read ahead as many tokens as the longest input
+func (sf *SynonymFilter) fill() error {
+	max := sf.synonyms.maxInput
+	if max < 1 {
+		max = 1
+	}
+	for !sf.exhausted && len(sf.lookahead) < max {
+		t, err := sf.input.Next()
+		if err != nil {
+			return err
+		}
+		if t == nil {
+			sf.exhausted = true
+			break
+		}
+		sf.lookahead = append(sf.lookahead, t)
+	}
+	return nil
+}
+
+// match longest rule at the start of the lookahead
+func (sf *SynonymFilter) match() (int, *synonymRule) {
+	var (
+		best  *synonymRule
+		bestN int
+		words []string
+	)
+	for i, t := range sf.lookahead {
+		if i > 0 && t.PositionIncrement != 1 { // inputs are consecutive words
+			break
+		}
+		words = append(words, t.TermText)
+		key := strings.Join(words, synonymSeparator)
+		if rule := sf.synonyms.lookup(key); rule != nil {
+			best = rule
+			bestN = i + 1
+		}
+		if !sf.synonyms.prefixes[key] {
+			break
+		}
+	}
+	return bestN, best
+}
+
+// emitGraph emit all paths of a match
+func (sf *SynonymFilter) emitGraph(n int, rule *synonymRule) {
+	type arc struct {
+		token    *Token
+		from, to int64
+	}
+
+	first := sf.lookahead[0]
+	start := first.StartOffset
+	end := sf.lookahead[n-1].EndOffset
+
+	paths := [][]*Token{}
+	if rule.keepOrig {
+		paths = append(paths, sf.lookahead[:n])
+	}
+	for _, output := range rule.outputs {
+		path := []*Token{}
+		for _, word := range output {
+			path = append(path, newToken(word, start, end, "SYNONYM"))
+		}
+		paths = append(paths, path)
+	}
+
+	// node 0 is the start, then every intermediate node, then the end
+	intermediates := int64(0)
+	for _, path := range paths {
+		intermediates = intermediates + int64(len(path)-1)
+	}
+	endNode := intermediates + 1
+
+	arcs := []arc{}
+	next := int64(1)
+	for _, path := range paths {
+		from := int64(0)
+		for i, t := range path {
+			to := endNode
+			if i < len(path)-1 {
+				to = next
+				next = next + 1
+			}
+			arcs = append(arcs, arc{token: t, from: from, to: to})
+			from = to
+		}
+	}
+	sort.SliceStable(arcs, func(i, j int) bool {
+		return arcs[i].from < arcs[j].from
+	})
+
+	last := int64(0)
+	for i, a := range arcs {
+		t := a.token
+		if i == 0 {
+			t.PositionIncrement = first.PositionIncrement
+		} else {
+			t.PositionIncrement = a.from - last
+		}
+		t.PositionLength = a.to - a.from
+		last = a.from
+		sf.emitted = append(sf.emitted, t)
+	}
+}
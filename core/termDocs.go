@@ -0,0 +1,161 @@
+package core
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+/*
+The postings of a term are read from the .frq and .prx files of its segment, at the pointers of its TermInfo.
+
+For each document containing the term, in document order, the .frq file has the document number
+as a delta of the document before, shifted left by one, with the low bit set when the term occurs once,
+otherwise followed by its frequency.
+The .prx file has the positions of the term in each of these documents, as deltas of the position before.
+*/
+
+// TermDoc a document containing a term
+type TermDoc struct {
+	Doc       int64   // document number
+	Freq      int64   // occurrences of the term in the document
+	Positions []int64 // positions of the occurrences
+}
+
+// termInfo term info of a term in the segment, false when the segment does not have it
+func (sr *SegmentReader) termInfo(term Term) (*TermInfo, bool) {
+	segTerms := sr.termsReader.segTerms
+	i := sort.Search(len(segTerms.terms), func(i int) bool {
+		return segTerms.terms[i].compare(term) >= 0
+	})
+	if i == len(segTerms.terms) || segTerms.terms[i].compare(term) != 0 {
+		return nil, false
+	}
+	return segTerms.termInfos[i], true
+}
+
+// docFreq number of documents of the segment containing a term
+func (sr *SegmentReader) docFreq(term Term) int64 {
+	ti, found := sr.termInfo(term)
+	if !found {
+		return 0
+	}
+	return ti.docFrq
+}
+
+// TermDocs documents of the segment containing a term, in document order, but the deleted ones
+func (sr *SegmentReader) TermDocs(fieldName, text string) ([]TermDoc, error) {
+	ti, found := sr.termInfo(Term{field: fieldName, text: text})
+	if !found {
+		return nil, nil
+	}
+	termDocs, err := sr.readPostings(ti)
+	if err != nil || sr.deletedDocs == nil {
+		return termDocs, err
+	}
+	live := termDocs[:0]
+	for _, td := range termDocs {
+		if !sr.deletedDocs.Get(td.Doc) {
+			live = append(live, td)
+		}
+	}
+	return live, nil
+}
+
+// readPostings read the postings of a term info
+func (sr *SegmentReader) readPostings(ti *TermInfo) ([]TermDoc, error) {
+	err := sr.freqStream.seekFrom(ti.frqPtr)
+	if err != nil {
+		return nil, err
+	}
+	err = sr.proxStream.seekFrom(ti.prxPtr)
+	if err != nil {
+		return nil, err
+	}
+
+	termDocs := make([]TermDoc, 0, ti.docFrq)
+	doc := int64(0)
+	i := int64(0)
+	for i < ti.docFrq {
+		code, err := sr.freqStream.readVarInt64()
+		if err != nil {
+			return nil, err
+		}
+		doc = doc + code>>1
+		freq := int64(1)
+		if code&1 == 0 {
+			freq, err = sr.freqStream.readVarInt64()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		positions := make([]int64, freq)
+		position := int64(0)
+		k := int64(0)
+		for k < freq {
+			delta, err := sr.proxStream.readVarInt64()
+			if err != nil {
+				return nil, err
+			}
+			position = position + delta
+			positions[k] = position
+			k = k + 1
+		}
+
+		termDocs = append(termDocs, TermDoc{Doc: doc, Freq: freq, Positions: positions})
+		i = i + 1
+	}
+	return termDocs, nil
+}
+
+// normBytes length norms of a field, one byte per document, nil when the segment has none
+func (sr *SegmentReader) normBytes(fieldName string) ([]byte, error) {
+	norm, found := (*sr.norms)[fieldName]
+	if !found {
+		return nil, nil
+	}
+	if norm.bytes == nil {
+		b := make([]byte, sr.maxDoc())
+		n, err := norm.fPtr.file.ReadAt(b, 0)
+		if err == io.EOF {
+			return nil, fmt.Errorf("norms of field %s: %d bytes for %d documents", fieldName, n, len(b))
+		}
+		if err != nil {
+			return nil, err
+		}
+		norm.bytes = b
+	}
+	return norm.bytes, nil
+}
+
+// writePostings append the postings of a term to the .frq and .prx files,
+// the documents numbered from base, return the last document written
+func writePostings(frqPtr, prxPtr *File, termDocs []TermDoc, base, lastDoc int64) (int64, error) {
+	for _, td := range termDocs {
+		doc := base + td.Doc
+		delta := (doc - lastDoc) << 1
+		var err error
+		if td.Freq == 1 { // optimize freq=1
+			err = frqPtr.writeVarInt64(delta | 1) // set low bit of doc num.
+		} else {
+			err = frqPtr.writeVarInt64(delta)
+			if err == nil {
+				err = frqPtr.writeVarInt64(td.Freq)
+			}
+		}
+		if err != nil {
+			return lastDoc, err
+		}
+		lastPosition := int64(0)
+		for _, position := range td.Positions {
+			err = prxPtr.writeVarInt64(position - lastPosition)
+			if err != nil {
+				return lastDoc, err
+			}
+			lastPosition = position
+		}
+		lastDoc = doc
+	}
+	return lastDoc, nil
+}
@@ -0,0 +1,415 @@
+package core
+
+import (
+	"fmt"
+	"os"
+	"path"
+)
+
+/*
+A term vector is the list of the terms of a field in one document,
+sorted by text, with their frequency and optionally their positions and offsets.
+Vectors are written next to the stored fields, for the fields whose FieldInfo stores term vectors:
+
+	.tvx  per document, the int64 pointer to its entry in .tvd
+	.tvd  per document, the field count, then the field number and int64 pointer into .tvf of each vector
+	.tvf  per vector, the term count and a flags byte (1 positions, 2 offsets),
+	      then per term its text, frequency, position deltas and start offset and length pairs
+
+Offsets are relative to the text of each value of a multi-valued field.
+*/
+
+// TermFreqVector terms of a field in a document
+type TermFreqVector struct {
+	Field     string
+	Terms     []string                 // sorted
+	Freqs     []int64                  // frequency of each term
+	Positions [][]int64                // positions of each term, nil when not stored
+	Offsets   [][]TermVectorOffsetInfo // offsets of each term, nil when not stored
+}
+
+// TermVectorOffsetInfo start and end offset of an occurence
+type TermVectorOffsetInfo struct {
+	StartOffset int64
+	EndOffset   int64
+}
+
+// TermVectorsWriter term vectors writer
+type TermVectorsWriter struct {
+	fieldInfos *FieldInfos
+	tvx        *File
+	tvd        *File
+	tvf        *File
+}
+
+// TermVectorsReader term vectors reader
+type TermVectorsReader struct {
+	fieldInfos *FieldInfos
+	tvx        *File
+	tvd        *File
+	tvf        *File
+	size       int64
+}
+
+// ================================TermFreqVector=======================================
+
+// IndexOf index of term in the vector, -1 when absent
+func (v *TermFreqVector) IndexOf(term string) int {
+	lo, hi := 0, len(v.Terms)-1
+	for lo <= hi {
+		mid := (lo + hi) / 2
+		switch {
+		case v.Terms[mid] < term:
+			lo = mid + 1
+		case v.Terms[mid] > term:
+			hi = mid - 1
+		default:
+			return mid
+		}
+	}
+	return -1
+}
+
+// postingsVectors term vectors of the postings of a document, postings sorted by term
+func postingsVectors(postings []Posting, fieldInfos *FieldInfos) []*TermFreqVector {
+	vectors := []*TermFreqVector{}
+	var v *TermFreqVector
+	var fi FieldInfo
+	for _, posting := range postings {
+		if v == nil || v.Field != posting.term.field {
+			v = nil
+			fi = fieldInfos.byName[posting.term.field]
+			if !fi.storeTermVector {
+				continue
+			}
+			v = &TermFreqVector{Field: fi.name}
+			if fi.storePositions {
+				v.Positions = [][]int64{}
+			}
+			if fi.storeOffsets {
+				v.Offsets = [][]TermVectorOffsetInfo{}
+			}
+			vectors = append(vectors, v)
+		}
+		v.Terms = append(v.Terms, posting.term.text)
+		v.Freqs = append(v.Freqs, posting.freq)
+		if v.Positions != nil {
+			v.Positions = append(v.Positions, posting.positions)
+		}
+		if v.Offsets != nil {
+			v.Offsets = append(v.Offsets, posting.offsets)
+		}
+	}
+	return vectors
+}
+
+// ================================TermVectorsWriter=======================================
+
+// init create the vector files of a segment
+func (tw *TermVectorsWriter) init(dirPath string, segment string, fn *FieldInfos) error {
+	var err error
+	tw.fieldInfos = fn
+
+	tw.tvx, err = CreateFile(path.Join(dirPath, segment+FileSuffix["vectorIndex"]), false, false)
+	if err != nil {
+		return err
+	}
+	tw.tvd, err = CreateFile(path.Join(dirPath, segment+FileSuffix["vectorDocuments"]), false, false)
+	if err != nil {
+		return err
+	}
+	tw.tvf, err = CreateFile(path.Join(dirPath, segment+FileSuffix["vectorFields"]), false, false)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// addDocument add the vectors of the next document, may be none
+func (tw *TermVectorsWriter) addDocument(vectors []*TermFreqVector) error {
+	size, err := tw.tvd.getSize()
+	if err != nil {
+		return err
+	}
+	err = tw.tvx.writeInt64(size)
+	if err != nil {
+		return err
+	}
+	err = tw.tvd.writeVarInt(len(vectors))
+	if err != nil {
+		return err
+	}
+	for _, v := range vectors {
+		fieldNumber, err := tw.fieldInfos.getNumber(v.Field)
+		if err != nil {
+			return err
+		}
+		fp, err := tw.tvf.getSize()
+		if err != nil {
+			return err
+		}
+		err = tw.tvd.writeVarInt64(fieldNumber)
+		if err != nil {
+			return err
+		}
+		err = tw.tvd.writeInt64(fp)
+		if err != nil {
+			return err
+		}
+		err = tw.writeField(v)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeField write a vector to tvf
+func (tw *TermVectorsWriter) writeField(v *TermFreqVector) error {
+	var flags byte
+	if v.Positions != nil {
+		flags = flags | 1
+	}
+	if v.Offsets != nil {
+		flags = flags | 2
+	}
+	err := tw.tvf.writeVarInt(len(v.Terms))
+	if err != nil {
+		return err
+	}
+	err = tw.tvf.writeByte(flags)
+	if err != nil {
+		return err
+	}
+	for i, term := range v.Terms {
+		err = tw.tvf.writeString(term)
+		if err != nil {
+			return err
+		}
+		err = tw.tvf.writeVarInt64(v.Freqs[i])
+		if err != nil {
+			return err
+		}
+		if v.Positions != nil {
+			lastPosition := int64(0)
+			for _, position := range v.Positions[i] {
+				err = tw.tvf.writeVarInt64(position - lastPosition)
+				if err != nil {
+					return err
+				}
+				lastPosition = position
+			}
+		}
+		if v.Offsets != nil {
+			for _, offset := range v.Offsets[i] {
+				err = tw.tvf.writeVarInt64(offset.StartOffset)
+				if err != nil {
+					return err
+				}
+				err = tw.tvf.writeVarInt64(offset.EndOffset - offset.StartOffset)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// close close the vector files
+func (tw *TermVectorsWriter) close() error {
+	var err error
+	for _, f := range []*File{tw.tvx, tw.tvd, tw.tvf} {
+		if f == nil {
+			continue
+		}
+		if e := f.close(); err == nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// ================================TermVectorsReader=======================================
+
+// init open the vector files of a segment, found is false when the segment has none
+func (tr *TermVectorsReader) init(dirPath string, segment string, fn *FieldInfos) (bool, error) {
+	var err error
+	tr.fieldInfos = fn
+
+	tvxPath := path.Join(dirPath, segment+FileSuffix["vectorIndex"])
+	if _, err = os.Stat(tvxPath); os.IsNotExist(err) {
+		return false, nil
+	}
+	tr.tvx, err = CreateFile(tvxPath, false, true)
+	if err != nil {
+		return false, err
+	}
+	tr.tvd, err = CreateFile(path.Join(dirPath, segment+FileSuffix["vectorDocuments"]), false, true)
+	if err != nil {
+		tr.close()
+		return false, err
+	}
+	tr.tvf, err = CreateFile(path.Join(dirPath, segment+FileSuffix["vectorFields"]), false, true)
+	if err != nil {
+		tr.close()
+		return false, err
+	}
+	size, err := tr.tvx.getSize()
+	if err != nil {
+		tr.close()
+		return false, err
+	}
+	tr.size = size / 8
+	return true, nil
+}
+
+// docVectors all vectors of a document
+func (tr *TermVectorsReader) docVectors(n int64) ([]*TermFreqVector, error) {
+	return tr.vectors(n, "")
+}
+
+// fieldVector vector of a field of a document, nil when the field has none
+func (tr *TermVectorsReader) fieldVector(n int64, fieldName string) (*TermFreqVector, error) {
+	vectors, err := tr.vectors(n, fieldName)
+	if err != nil || len(vectors) == 0 {
+		return nil, err
+	}
+	return vectors[0], nil
+}
+
+// vectors vectors of the fields of document n, only of fieldName when not empty
+func (tr *TermVectorsReader) vectors(n int64, fieldName string) ([]*TermFreqVector, error) {
+	if n < 0 || n >= tr.size {
+		return nil, fmt.Errorf("document %d out of range [0, %d)", n, tr.size)
+	}
+	err := tr.tvx.seekFrom(n * 8)
+	if err != nil {
+		return nil, err
+	}
+	position, err := tr.tvx.readInt64()
+	if err != nil {
+		return nil, err
+	}
+	err = tr.tvd.seekFrom(position)
+	if err != nil {
+		return nil, err
+	}
+	numFields, err := tr.tvd.readVarInt()
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	pointers := []int64{}
+	for i := 0; i < numFields; i++ {
+		fieldNumber, err := tr.tvd.readVarInt()
+		if err != nil {
+			return nil, err
+		}
+		fp, err := tr.tvd.readInt64()
+		if err != nil {
+			return nil, err
+		}
+		name, err := tr.fieldInfos.getFieldName(fieldNumber)
+		if err != nil {
+			return nil, err
+		}
+		if fieldName == "" || name == fieldName {
+			names = append(names, name)
+			pointers = append(pointers, fp)
+		}
+	}
+
+	vectors := []*TermFreqVector{}
+	for i, fp := range pointers {
+		v, err := tr.readField(names[i], fp)
+		if err != nil {
+			return nil, err
+		}
+		vectors = append(vectors, v)
+	}
+	return vectors, nil
+}
+
+// readField read a vector from tvf
+func (tr *TermVectorsReader) readField(fieldName string, fp int64) (*TermFreqVector, error) {
+	err := tr.tvf.seekFrom(fp)
+	if err != nil {
+		return nil, err
+	}
+	numTerms, err := tr.tvf.readVarInt()
+	if err != nil {
+		return nil, err
+	}
+	flags, err := tr.tvf.readByte()
+	if err != nil {
+		return nil, err
+	}
+
+	v := &TermFreqVector{
+		Field: fieldName,
+		Terms: make([]string, numTerms),
+		Freqs: make([]int64, numTerms),
+	}
+	if flags&1 != 0 {
+		v.Positions = make([][]int64, numTerms)
+	}
+	if flags&2 != 0 {
+		v.Offsets = make([][]TermVectorOffsetInfo, numTerms)
+	}
+	for i := 0; i < numTerms; i++ {
+		v.Terms[i], err = tr.tvf.readString()
+		if err != nil {
+			return nil, err
+		}
+		freq, err := tr.tvf.readVarInt64()
+		if err != nil {
+			return nil, err
+		}
+		v.Freqs[i] = freq
+		if v.Positions != nil {
+			positions := make([]int64, freq)
+			lastPosition := int64(0)
+			for k := range positions {
+				delta, err := tr.tvf.readVarInt64()
+				if err != nil {
+					return nil, err
+				}
+				lastPosition = lastPosition + delta
+				positions[k] = lastPosition
+			}
+			v.Positions[i] = positions
+		}
+		if v.Offsets != nil {
+			offsets := make([]TermVectorOffsetInfo, freq)
+			for k := range offsets {
+				start, err := tr.tvf.readVarInt64()
+				if err != nil {
+					return nil, err
+				}
+				length, err := tr.tvf.readVarInt64()
+				if err != nil {
+					return nil, err
+				}
+				offsets[k] = TermVectorOffsetInfo{StartOffset: start, EndOffset: start + length}
+			}
+			v.Offsets[i] = offsets
+		}
+	}
+	return v, nil
+}
+
+// close close the vector files
+func (tr *TermVectorsReader) close() error {
+	var err error
+	for _, f := range []*File{tr.tvx, tr.tvd, tr.tvf} {
+		if f == nil {
+			continue
+		}
+		if e := f.close(); err == nil {
+			err = e
+		}
+	}
+	return err
+}
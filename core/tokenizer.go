@@ -0,0 +1,237 @@
+package core
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"unicode"
+)
+
+/*
+A Tokenizer is a TokenStream whose input is a Reader.
+
+Tokenizers read their input rune by rune through a buffered reader,
+so that large inputs are never held in memory as a whole.
+When the input is a CharFilter, token offsets are corrected to the original text.
+*/
+
+// CharTokenizer tokenizer emitting runs of token characters
+type CharTokenizer struct {
+	source      io.Reader
+	input       *bufio.Reader
+	offset      int64             // byte offset of the next rune
+	isTokenChar func(r rune) bool // which runes belong to a token
+	normalize   func(r rune) rune // applied to each token rune
+}
+
+// StandardTokenizer words of letters and digits, one token per ideograph
+type StandardTokenizer struct {
+	source io.Reader
+	input  *bufio.Reader
+	offset int64 // byte offset of the next rune
+}
+
+// KeywordTokenizer emit the entire input as a single token
+type KeywordTokenizer struct {
+	source io.Reader
+	done   bool
+}
+
+var (
+	// MaxTokenLength longer runs are split into several tokens
+	MaxTokenLength = 255
+)
+
+// NewCharTokenizer new char tokenizer
+func NewCharTokenizer(reader io.Reader, isTokenChar func(r rune) bool, normalize func(r rune) rune) *CharTokenizer {
+	return &CharTokenizer{
+		source:      reader,
+		input:       bufio.NewReader(reader),
+		isTokenChar: isTokenChar,
+		normalize:   normalize,
+	}
+}
+
+// NewWhitespaceTokenizer divide text at whitespace
+func NewWhitespaceTokenizer(reader io.Reader) *CharTokenizer {
+	isTokenChar := func(r rune) bool {
+		return !unicode.IsSpace(r)
+	}
+	return NewCharTokenizer(reader, isTokenChar, nil)
+}
+
+// NewLetterTokenizer divide text at non-letters
+func NewLetterTokenizer(reader io.Reader) *CharTokenizer {
+	return NewCharTokenizer(reader, unicode.IsLetter, nil)
+}
+
+// NewStandardTokenizer new standard tokenizer
+func NewStandardTokenizer(reader io.Reader) *StandardTokenizer {
+	return &StandardTokenizer{
+		source: reader,
+		input:  bufio.NewReader(reader),
+	}
+}
+
+// NewKeywordTokenizer new keyword tokenizer
+func NewKeywordTokenizer(reader io.Reader) *KeywordTokenizer {
+	return &KeywordTokenizer{
+		source: reader,
+	}
+}
+
+// isIdeographic han, hiragana and katakana are indexed one rune per token
+func isIdeographic(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
+
+// isWordChar letters, digits and combining marks
+func isWordChar(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r)
+}
+
+// closeSource close the reader if it can be closed
+func closeSource(source io.Reader) error {
+	if c, ok := source.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// ================================CharTokenizer=======================================
+
+// Next next token
+func (ct *CharTokenizer) Next() (*Token, error) {
+	var (
+		b          strings.Builder
+		start, end int64
+		length     int
+	)
+	for {
+		r, size, err := ct.input.ReadRune()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !ct.isTokenChar(r) {
+			ct.offset = ct.offset + int64(size)
+			if length > 0 {
+				break
+			}
+			continue
+		}
+
+		if length == 0 {
+			start = ct.offset
+		}
+		if ct.normalize != nil {
+			r = ct.normalize(r)
+		}
+		b.WriteRune(r)
+		length = length + 1
+		ct.offset = ct.offset + int64(size)
+		end = ct.offset
+
+		if length >= MaxTokenLength {
+			break
+		}
+	}
+
+	if length == 0 {
+		return nil, nil
+	}
+	return newToken(b.String(), correctOffset(ct.source, start), correctOffset(ct.source, end), "word"), nil
+}
+
+// Close close input
+func (ct *CharTokenizer) Close() error {
+	return closeSource(ct.source)
+}
+
+// ================================StandardTokenizer=======================================
+
+// Next next token
+func (st *StandardTokenizer) Next() (*Token, error) {
+	var (
+		b          strings.Builder
+		start, end int64
+		length     int
+	)
+	for {
+		r, size, err := st.input.ReadRune()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if isIdeographic(r) {
+			if length > 0 { // finish the current word first
+				st.input.UnreadRune()
+				break
+			}
+			start = st.offset
+			st.offset = st.offset + int64(size)
+			return newToken(string(r), correctOffset(st.source, start), correctOffset(st.source, st.offset), "<IDEOGRAPHIC>"), nil
+		}
+
+		if !isWordChar(r) {
+			st.offset = st.offset + int64(size)
+			if length > 0 {
+				break
+			}
+			continue
+		}
+
+		if length == 0 {
+			start = st.offset
+		}
+		b.WriteRune(r)
+		length = length + 1
+		st.offset = st.offset + int64(size)
+		end = st.offset
+
+		if length >= MaxTokenLength {
+			break
+		}
+	}
+
+	if length == 0 {
+		return nil, nil
+	}
+	return newToken(b.String(), correctOffset(st.source, start), correctOffset(st.source, end), "<ALPHANUM>"), nil
+}
+
+// Close close input
+func (st *StandardTokenizer) Close() error {
+	return closeSource(st.source)
+}
+
+// ================================KeywordTokenizer=======================================
+
+// Next the whole input, once
+func (kt *KeywordTokenizer) Next() (*Token, error) {
+	if kt.done {
+		return nil, nil
+	}
+	kt.done = true
+
+	var b strings.Builder
+	n, err := io.Copy(&b, kt.source)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, nil
+	}
+	return newToken(b.String(), correctOffset(kt.source, 0), correctOffset(kt.source, n), "word"), nil
+}
+
+// Close close input
+func (kt *KeywordTokenizer) Close() error {
+	return closeSource(kt.source)
+}
@@ -18,10 +18,12 @@ var (
 		"termInfoIndex":   ".tii", // term info index, The index into the Term Infos
 		"termInfos":       ".tis", // term infos, part of the term dictionary, stores term info
 		"norms":           ".f",   // norms
+		"vectorIndex":     ".tvx", // term vector index, Contains pointers to the document vectors
+		"vectorDocuments": ".tvd", // term vector documents, The fields with a vector of each document
+		"vectorFields":    ".tvf", // term vector fields, The terms, frequencies, positions and offsets of each vector
+		"docValues":       ".dv",  // doc values, The column of values of a field
+		"deletedDocs":     ".del", // deleted docs, The bitset of the deleted documents
 	}
-
-	// IndexInterval index interval
-	IndexInterval int64 = 128
 )
 
 // Int64ToByte int64 to []byte
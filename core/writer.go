@@ -1,9 +1,14 @@
 package core
 
 import (
-	"math"
+	"fmt"
+	"io/ioutil"
+	"os"
 	"path"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 )
 
 /*
@@ -14,214 +19,703 @@ or whether an existing index is opened for the addition of new documents.
 In either case, documents are added with the addDocument method,
 When finished adding documents, close should be called.
 
+The settings of a writer are those of the IndexWriterConfig it is opened with, see OpenWriter,
+so writers of different indexes in a process do not share them.
+
+Added documents are buffered in memory, and written as a new segment
+once they use RAMBufferSize bytes or number MaxBufferedDocs, or when the writer is flushed or closed.
+
+AddDocument may be called from many goroutines at once, each indexes into a buffer of its own,
+the analyzer must then be safe for concurrent use.
+A written segment is published by renaming the new segments file over the old one,
+so a reader opening the index sees either all of it or none of it.
+
+Documents are removed with DeleteDocuments or DeleteByQuery, the deletes are buffered and applied when the writer flushes,
+a merge drops the deleted documents of its segments.
+
+After a segment is written, its MergePolicy chooses segments to merge, and its MergeScheduler runs the merges,
+inline with a SerialMergeScheduler, the default, or in the background with a ConcurrentMergeScheduler.
+A segment is merged by one merge at a time, the merged segment replaces its segments once written.
+Close waits for the running merges, CloseAbortingMerges stops them.
+
 If an index will not have more documents added for a while and optimal search performance is desired,
 then the optimize method should be called before the index is closed.
 */
 
 // Writer index writer
 type Writer struct {
-	dir      *File         // where this index resides
-	analyzer Analyzer      // how to analyze text
-	segInfos *SegmentInfos // the segments
-	ramDir   *File         // for temp segs
-}
-
-var (
-	// MaxFieldLength max field length
-	MaxFieldLength int64 = 10000
-
-	// Determines how often segment indexes are merged by addDocument().
-	// With smaller values, less RAM is used while indexing,
-	// and searches on unoptimized indexes are faster, but indexing speed is slower.
-	// With larger values more RAM is used while indexing and searches on unoptimized indexes are slower,
-	// but indexing is faster.
-	// Thus larger values (> 10) are best for batched index creation,
-	// and smaller values (< 10) for indexes that are interactively maintained.
-	// This must never be less than 2.  The default value is 10.
-
-	// MergeFactor merge factor
-	MergeFactor int64 = 10
-
-	// Determines the largest number of documents ever merged by addDocument()
-	// Small values (e.g., less than 10,000) are best for interactive indexing,
-	// as this limits the length of pauses while indexing to a few seconds.
-	// Larger values are best for batched indexing and speedier searches.
-	// The default value is math.MaxInt64
-
-	// MaxMergeDocs max merge docs
-	MaxMergeDocs int64 = math.MaxInt64
-)
+	ramBytes       int64                // bytes used by all buffers, updated atomically
+	mu             sync.Mutex           // guards segInfos, schema and merges
+	config         *IndexWriterConfig   // settings, copied when opened
+	infoMu         sync.Mutex           // orders the lines of the info stream
+	dir            *File                // where this index resides
+	analyzer       Analyzer             // how to analyze text, with the analyzers named by the schema
+	segInfos       *SegmentInfos        // the segments
+	pool           *documentsWriterPool // buffers of documents not yet written
+	schema         *Schema              // declared fields, nil when undeclared
+	mergePolicy    MergePolicy          // chooses the segments to merge
+	mergeScheduler MergeScheduler       // runs the merges
+	pendingMerges  []*OneMerge          // merges not started yet
+	runningMerges  []*OneMerge          // merges started
+	merging        map[string]bool      // segments of pending and running merges
+	mergeDone      *sync.Cond           // signaled when a merge ends
+	abortMerges    bool                 // no merge is started while set
+	pendingDeletes []bufferedDelete     // deletes not applied to the segments yet
+	delGen         int64                // gen of the last delete
+	closed         bool                 // set by Close, no delete is buffered after it
+}
 
-// Init init writer
+// mergeSource the pending merges of a writer, for its scheduler
+type mergeSource struct {
+	w *Writer
+}
+
+// OpenWriter open the index of a directory with the settings of a config, copied and validated
+func OpenWriter(dirPath string, config *IndexWriterConfig) (*Writer, error) {
+	w := new(Writer)
+	err := w.open(dirPath, config)
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+// Init init writer, creating a new index or appending to the existing one, with the default settings
 func (w *Writer) Init(Dirpath string, analyzer Analyzer, create bool) error {
+	config := NewIndexWriterConfig(analyzer)
+	config.OpenMode = OpenModeAppend
+	if create {
+		config.OpenMode = OpenModeCreate
+	}
+	return w.open(Dirpath, config)
+}
+
+// open open the index of a directory with a copy of config
+func (w *Writer) open(Dirpath string, config *IndexWriterConfig) error {
+	err := config.validate()
+	if err != nil {
+		return err
+	}
+	config = config.clone()
+
 	fPtr, err := CreateFile(Dirpath, true, true)
 	if err != nil {
 		return err
 	}
 
 	w.dir = fPtr
-	w.analyzer = analyzer
+	w.config = config
+	w.analyzer = config.Analyzer
 
 	segsPtr := new(SegmentInfos)
 	segsPtr.empty()
 
 	w.segInfos = segsPtr
+	w.pool = newDocumentsWriterPool(config)
+	w.mergePolicy = config.MergePolicy
+	w.mergeScheduler = config.MergeScheduler
+	w.pendingMerges = nil
+	w.runningMerges = nil
+	w.merging = map[string]bool{}
+	w.mergeDone = sync.NewCond(&w.mu)
+	w.abortMerges = false
+	w.pendingDeletes = nil
+	w.delGen = 0
+	w.ramBytes = 0
+
+	create := config.OpenMode == OpenModeCreate
+	if config.OpenMode == OpenModeCreateOrAppend {
+		_, err = os.Stat(path.Join(Dirpath, "segments"))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		create = os.IsNotExist(err)
+	}
+
+	if create {
+		w.message("create index %s", Dirpath)
+		err = w.segInfos.write(fPtr)
+		if err != nil {
+			return err
+		}
+		err = os.Remove(path.Join(Dirpath, "schema"))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		w.schema = nil
+		return nil
+	}
 
-	tempDir := "/tmp"
-	tPtr, err := CreateTempFile(tempDir, "pre", true)
+	err = w.segInfos.read(Dirpath) // open the existing index
 	if err != nil {
 		return err
 	}
+	w.message("append to index %s of %d segments", Dirpath, w.segInfos.Len())
+	w.schema, err = readIndexSchema(Dirpath)
+	if err != nil {
+		return err
+	}
+	w.analyzer, err = w.schemaAnalyzer(w.schema)
+	return err
+}
+
+// Config copy of the settings of the writer
+func (w *Writer) Config() *IndexWriterConfig {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	config := w.config.clone()
+	config.MergePolicy = copyMergePolicy(w.mergePolicy)
+	config.MergeScheduler = w.mergeScheduler
+	return config
+}
 
-	w.ramDir = tPtr
+// message log to the info stream of the config, when set
+func (w *Writer) message(format string, args ...interface{}) {
+	if w.config.InfoStream == nil {
+		return
+	}
+	w.infoMu.Lock()
+	defer w.infoMu.Unlock()
+	fmt.Fprintf(w.config.InfoStream, "IW: "+format+"\n", args...)
+}
 
-	if create {
-		w.segInfos.write(fPtr)
+// SetSchema declare the fields of the index, documents not matching it are rejected,
+// the schema is kept in the index and may only add fields to the schema it has,
+// the fields the index already has must be declared as they were indexed
+func (w *Writer) SetSchema(schema *Schema) error {
+	buffers := w.pool.lockAll() // no document is added, nor a buffer created, while the schema changes
+	defer w.pool.unlockAll(buffers)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.schema != nil {
+		err := schema.extends(w.schema)
+		if err != nil {
+			return err
+		}
 	}
+	fieldInfos, err := w.indexFieldInfos(buffers)
+	if err != nil {
+		return err
+	}
+	err = schema.agrees(fieldInfos)
+	if err != nil {
+		return err
+	}
+	analyzer, err := w.schemaAnalyzer(schema)
+	if err != nil {
+		return err
+	}
+	err = schema.write(w.dir.filePath)
+	if err != nil {
+		return err
+	}
+	w.schema = schema
+	w.analyzer = analyzer
 	return nil
+}
+
+// indexFieldInfos fields of the segments and of the locked buffers, with w.mu held
+func (w *Writer) indexFieldInfos(buffers []*DocumentsWriter) (*FieldInfos, error) {
+	fieldInfos := new(FieldInfos)
+	fieldInfos.empty()
+	for _, si := range w.segInfos.segInfos {
+		reader := &SegmentReader{seg: &si, fieldInfos: new(FieldInfos)}
+		reader.fieldInfos.empty()
+		err := reader.initFieldNames()
+		if err != nil {
+			return nil, err
+		}
+		err = fieldInfos.addFields(reader.fieldInfos)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, dsw := range buffers {
+		err := fieldInfos.addFields(dsw.fieldInfos)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return fieldInfos, nil
+}
 
+// schemaAnalyzer analyzer of the config, with the analyzers the schema names for its text fields
+func (w *Writer) schemaAnalyzer(schema *Schema) (Analyzer, error) {
+	if schema == nil || !schema.analyzes() {
+		return w.config.Analyzer, nil
+	}
+	if w.config.AnalyzerRegistry == nil {
+		return nil, fmt.Errorf("schema names analyzers, the config has no analyzer registry")
+	}
+	analyzer, err := schema.PerFieldAnalyzer(w.config.AnalyzerRegistry, w.config.Analyzer)
+	if err != nil {
+		return nil, err
+	}
+	return analyzer, nil
+}
+
+// SetMergePolicy choose the segments to merge with a policy, a LogDocMergePolicy by default
+func (w *Writer) SetMergePolicy(policy MergePolicy) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.mergePolicy = policy
+}
+
+// SetMergeScheduler run the merges with a scheduler, a SerialMergeScheduler by default,
+// the merges of the previous scheduler are waited for
+func (w *Writer) SetMergeScheduler(scheduler MergeScheduler) error {
+	w.mu.Lock()
+	previous := w.mergeScheduler
+	w.mergeScheduler = scheduler
+	w.mu.Unlock()
+	return previous.Close()
+}
+
+// Schema declared fields of the index, nil when undeclared
+func (w *Writer) Schema() *Schema {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.schema
 }
 
 // AddDocument Adds a document to this index
 func (w *Writer) AddDocument(doc Document) error {
+	return w.addDocuments([]Document{doc})
+}
 
-	dw := new(DocumentWriter)
-	dw.Init(w.ramDir.filePath, w.analyzer, MaxFieldLength)
-	segment := w.newSegName()
-	dw.AddDocument(segment, doc)
-
-	seg := SegmentInfo{
-		name:     segment,
-		docCount: 1,
-		dirPath:  w.ramDir.filePath,
+// AddDocuments Adds a block of documents to this index, the children first and their parent last.
+// The documents get consecutive numbers, they are written to the same segment,
+// which merges copy as a whole, so the block is never split, see ToParentBlockJoinQuery.
+// No document of the block is added when one of them is rejected.
+func (w *Writer) AddDocuments(block []Document) error {
+	if len(block) == 0 {
+		return nil
 	}
+	return w.addDocuments(block)
+}
 
-	w.segInfos.add(seg)
+// addDocuments buffer documents, and flush them when the buffer is full
+func (w *Writer) addDocuments(docs []Document) error {
+	dsw := w.pool.obtain()
+	defer w.pool.release(dsw)
+
+	w.mu.Lock()
+	schema := w.schema
+	analyzer := w.analyzer
+	w.mu.Unlock()
+	if schema != nil {
+		for _, doc := range docs {
+			err := schema.Validate(doc)
+			if err != nil {
+				return err
+			}
+		}
+	}
 
-	w.maybeMergeSegs()
+	before := dsw.RAMBytesUsed()
+	err := dsw.addDocuments(docs, analyzer)
+	if err != nil {
+		return err
+	}
+	ramBytes := atomic.AddInt64(&w.ramBytes, dsw.RAMBytesUsed()-before)
 
+	if (w.config.RAMBufferSize > 0 && ramBytes >= w.config.RAMBufferSize) ||
+		(w.config.MaxBufferedDocs > 0 && dsw.NumDocs() >= w.config.MaxBufferedDocs) {
+		return w.flushBuffer(dsw)
+	}
 	return nil
 }
 
-// newSegName new segment name
-func (w *Writer) newSegName() string {
-	nCounter := w.segInfos.counter + 1
-	return "_" + strconv.FormatInt(nCounter, 10)
+// Flush write the buffered documents as new segments, and apply the buffered deletes
+func (w *Writer) Flush() error {
+	for _, dsw := range w.pool.all() {
+		dsw.mu.Lock()
+		err := w.flushBuffer(dsw)
+		dsw.mu.Unlock()
+		if err != nil {
+			return err
+		}
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.applyDeletes()
 }
 
-// Close close
-func (w *Writer) Close() error {
-	w.flushRAMSegs()
-	w.closeRAMDir()
-	return nil
+// flushBuffer write the documents of a locked buffer as a new segment, and publish it
+func (w *Writer) flushBuffer(dsw *DocumentsWriter) error {
+	if dsw.NumDocs() == 0 {
+		return nil
+	}
+	w.mu.Lock()
+	segment := w.newSegName()
+	w.mu.Unlock()
 
+	ramBytes := dsw.RAMBytesUsed()
+	deletes := dsw.deletes
+	seg, err := dsw.flush(w.dir.filePath, segment)
+	if err != nil {
+		return err
+	}
+	atomic.AddInt64(&w.ramBytes, -ramBytes)
+	w.message("flush segment %s of %d documents, %d bytes buffered", segment, seg.docCount, ramBytes)
+	seg.delCount, err = deleteSegmentDocs(seg, deletes) // not published yet, the buffer is still locked
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	err = w.applyDeletes() // to the segments written before
+	if err != nil {
+		w.mu.Unlock()
+		return err
+	}
+	seg.gen = w.delGen
+	w.segInfos.add(seg)
+	err = w.segInfos.write(w.dir)
+	if err == nil {
+		err = w.registerMerges()
+	}
+	scheduler := w.mergeScheduler
+	w.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	return scheduler.Merge(mergeSource{w})
 }
 
-// FlushRAMSegs flush ram segments
-func (w *Writer) flushRAMSegs() error {
-	minSegment := int64(0)
-	w.mergeSegs(minSegment)
-	return nil
+// Optimize merge all segments into one, without the deleted documents, for the fastest search,
+// once the running merges are done
+func (w *Writer) Optimize() error {
+	err := w.Flush()
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	w.waitForMerges()
+	w.dropPendingMerges()
+	err = w.applyDeletes()
+	if err != nil {
+		w.mu.Unlock()
+		return err
+	}
+	if len(w.segInfos.segInfos) == 0 ||
+		(len(w.segInfos.segInfos) == 1 && w.segInfos.segInfos[0].delCount == 0) {
+		w.mu.Unlock()
+		return nil
+	}
+	merge := &OneMerge{Segments: append([]SegmentInfo{}, w.segInfos.segInfos...)}
+	for _, si := range merge.Segments {
+		w.merging[si.name] = true
+	}
+	w.runningMerges = append(w.runningMerges, merge)
+	w.mu.Unlock()
+
+	return w.merge(merge)
 }
 
-// delete dir
-func (w *Writer) closeRAMDir() error {
-	w.ramDir.removeAll()
-	return nil
+// newSegName new segment name, with w.mu held
+func (w *Writer) newSegName() string {
+	w.segInfos.counter = w.segInfos.counter + 1
+	return "_" + strconv.FormatInt(w.segInfos.counter, 10)
 }
 
-// MaybeMergeSegs merge segs
-func (w *Writer) maybeMergeSegs() error {
+// Close flush the buffered documents, and wait for the running merges
+func (w *Writer) Close() error {
+	err := w.Flush()
+	if e := w.waitMergeScheduler(); err == nil {
+		err = e
+	}
+	w.mu.Lock()
+	w.closed = true
+	w.mu.Unlock()
+	return err
+}
 
-	targetMergeDocs := MergeFactor // 10
+// CloseAbortingMerges flush the buffered documents, and stop the running merges,
+// their segments stay unmerged, the pending merges are dropped
+func (w *Writer) CloseAbortingMerges() error {
+	w.mu.Lock()
+	w.abortMerges = true
+	w.message("abort %d running merges, drop %d pending", len(w.runningMerges), len(w.pendingMerges))
+	for _, merge := range w.runningMerges {
+		merge.Abort()
+	}
+	w.dropPendingMerges()
+	w.mu.Unlock()
 
-	for targetMergeDocs <= MaxMergeDocs {
+	err := w.Flush()
+	if e := w.waitMergeScheduler(); err == nil {
+		err = e
+	}
 
-		minSegment := int64(len(w.segInfos.segInfos))
-		mergeDocs := int64(0)
+	w.mu.Lock()
+	w.abortMerges = false
+	w.closed = true
+	w.mu.Unlock()
+	return err
+}
 
-		minSegment = minSegment - 1 // minSegment use as index
+// waitMergeScheduler wait for the merges of the scheduler, and the merges run by other goroutines
+func (w *Writer) waitMergeScheduler() error {
+	w.mu.Lock()
+	scheduler := w.mergeScheduler
+	w.mu.Unlock()
+	err := scheduler.Close()
+
+	w.mu.Lock()
+	w.waitForMerges()
+	w.mu.Unlock()
+	return err
+}
 
-		for minSegment >= 0 {
-			si := w.segInfos.segInfos[minSegment]
-			if si.docCount >= targetMergeDocs {
-				break
-			}
-			mergeDocs = mergeDocs + si.docCount
-			minSegment = minSegment - 1
-		}
+// waitForMerges wait until no merge runs, with w.mu held
+func (w *Writer) waitForMerges() {
+	for len(w.runningMerges) > 0 {
+		w.mergeDone.Wait()
+	}
+}
 
-		if mergeDocs >= targetMergeDocs { // found a merge to do
-			w.mergeSegs(minSegment + 1) // -1 + 1 = 0
-		} else {
-			break
+// dropPendingMerges forget the merges not started yet, with w.mu held
+func (w *Writer) dropPendingMerges() {
+	for _, merge := range w.pendingMerges {
+		for _, si := range merge.Segments {
+			delete(w.merging, si.name)
 		}
-		targetMergeDocs = targetMergeDocs * MergeFactor
 	}
-	// while (targetMergeDocs <= maxMergeDocs) {
+	w.pendingMerges = nil
+}
 
-	// }
+// registerMerges queue the merges chosen by the merge policy,
+// but those of a segment already merging, with w.mu held
+func (w *Writer) registerMerges() error {
+	if w.abortMerges {
+		return nil
+	}
+	infos := *w.segInfos
+	infos.merging = w.merging
+	spec, err := w.mergePolicy.FindMerges(&infos)
+	if err != nil || spec == nil {
+		return err
+	}
+	for _, merge := range spec.Merges {
+		if len(merge.Segments) == 0 {
+			continue
+		}
+		busy := false
+		for _, si := range merge.Segments {
+			busy = busy || w.merging[si.name]
+		}
+		if busy {
+			continue
+		}
+		for _, si := range merge.Segments {
+			w.merging[si.name] = true
+		}
+		w.pendingMerges = append(w.pendingMerges, &OneMerge{Segments: merge.Segments})
+	}
 	return nil
+}
 
+// NextMerge next pending merge, now running, nil when none
+func (s mergeSource) NextMerge() *OneMerge {
+	w := s.w
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.abortMerges || len(w.pendingMerges) == 0 {
+		return nil
+	}
+	merge := w.pendingMerges[0]
+	w.pendingMerges = w.pendingMerges[1:]
+	w.runningMerges = append(w.runningMerges, merge)
+	return merge
 }
 
-// Pops segments off of segmentInfos stack down to minSegment, merges them,
-// and pushes the merged index onto the top of the segmentInfos stack.
+// Merge run a merge
+func (s mergeSource) Merge(merge *OneMerge) error {
+	return s.w.merge(merge)
+}
 
-// mergeSegs merge segments
-func (w *Writer) mergeSegs(minSegment int64) error {
+// Merges segments, and puts the merged segment at the place of the first of them in segmentInfos.
 
+// merge run a running merge: write the merged segment without w.mu, then replace its segments
+func (w *Writer) merge(merge *OneMerge) error {
+	w.mu.Lock()
 	mergedName := w.newSegName()
-	mergedDocCount := int64(0)
+	err := w.applyDeletes() // the merge drops the deleted documents
+	w.mu.Unlock()
 
-	merger := SegmentMerger{
-		dirPath: w.dir.filePath,
+	seg := SegmentInfo{
 		name:    mergedName,
-		readers: []*SegmentReader{},
+		dirPath: w.dir.filePath,
+	}
+	var deleted []*BitSet // deleted documents of the segments when merged
+	if err == nil {
+		w.message("merge %d segments into %s", len(merge.Segments), mergedName)
+		seg.docCount, deleted, err = mergeSegments(merge.Segments, seg, w.config, merge.progress(seg))
+	}
+	if err == nil && merge.Aborted() {
+		err = ErrMergeAborted
 	}
 
-	segsToDelete := []*SegmentReader{} // segment to delete
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	defer w.mergeDone.Broadcast()
+	for i, running := range w.runningMerges {
+		if running == merge {
+			w.runningMerges = append(w.runningMerges[:i], w.runningMerges[i+1:]...)
+			break
+		}
+	}
+	for _, si := range merge.Segments {
+		delete(w.merging, si.name)
+	}
 
-	for _, si := range w.segInfos.segInfos {
+	if err == nil {
+		err = w.applyDeletes()
+	}
+	if err == nil { // documents deleted while merging
+		seg.delCount, err = carryDeletes(merge.Segments, deleted, seg)
+	}
+	if err == nil {
+		seg.gen = w.delGen
+		err = w.commitMerge(merge.Segments, seg)
+	}
+	if err != nil {
+		w.message("merge into %s failed: %v", mergedName, err)
+		w.deleteSegments([]SegmentInfo{seg}) // the partly written segment
+		return err
+	}
+	w.message("merge into %s done, %d documents, %d deleted", mergedName, seg.docCount, seg.delCount)
 
+	err = w.deleteSegments(merge.Segments) // delete now-unused segments
+	if err != nil {
+		return err
+	}
+	return w.registerMerges()
+}
+
+// mergeSegments write the segments merged into seg, with the settings of config,
+// and return the documents of seg and the deleted documents of the segments dropped
+func mergeSegments(segsToMerge []SegmentInfo, seg SegmentInfo, config *IndexWriterConfig, progress func() error) (int64, []*BitSet, error) {
+	merger := SegmentMerger{
+		dirPath:  seg.dirPath,
+		name:     seg.name,
+		readers:  []*SegmentReader{},
+		config:   config,
+		progress: progress,
+	}
+
+	for _, si := range segsToMerge {
 		reader := new(SegmentReader)
-		reader.init(si)
+		err := reader.init(si)
+		if err != nil {
+			reader.close()
+			for _, r := range merger.readers {
+				r.close()
+			}
+			return 0, nil, err
+		}
 		merger.add(reader)
+	}
 
-		segsToDelete = append(segsToDelete, reader)
+	err := merger.merge()
 
-		mergedDocCount = mergedDocCount + si.docCount
+	deleted := []*BitSet{}
+	for _, reader := range merger.readers {
+		deleted = append(deleted, reader.deletedDocs)
+		reader.close()
+	}
+	return merger.docCount, deleted, err
+}
 
+// carryDeletes delete the documents of seg deleted from the merged segments since they were merged,
+// and return the number of deleted documents of seg
+func carryDeletes(segsToMerge []SegmentInfo, merged []*BitSet, seg SegmentInfo) (int64, error) {
+	var deleted *BitSet
+	doc := int64(0) // number in seg
+	for i, si := range segsToMerge {
+		current, err := readDeletedDocs(si.dirPath, si.name)
+		if err != nil {
+			return 0, err
+		}
+		for n := int64(0); n < si.docCount; n++ {
+			if merged[i] != nil && merged[i].Get(n) { // dropped by the merge
+				continue
+			}
+			if current != nil && current.Get(n) {
+				if deleted == nil {
+					deleted = NewBitSet(seg.docCount)
+				}
+				deleted.Set(doc)
+			}
+			doc = doc + 1
+		}
 	}
+	if deleted == nil {
+		return 0, nil
+	}
+	return deleted.Cardinality(), writeDeletedDocs(seg.dirPath, seg.name, deleted)
+}
 
-	merger.merge()
+// commitMerge replace the merged segments by seg, at the place of the first of them, with w.mu held
+func (w *Writer) commitMerge(segsToMerge []SegmentInfo, seg SegmentInfo) error {
+	merging := map[string]bool{}
+	for _, si := range segsToMerge {
+		merging[si.name] = true
+	}
+	first := -1
+	found := 0
+	for i, si := range w.segInfos.segInfos {
+		if merging[si.name] {
+			if first < 0 {
+				first = i
+			}
+			found = found + 1
+		}
+	}
+	if found != len(merging) {
+		return fmt.Errorf("merge of segments not in the index")
+	}
 
-	// w.SegInfos; // pop old infos & add new
-	seg := SegmentInfo{
-		name:     mergedName,
-		docCount: mergedDocCount,
-		dirPath:  w.dir.filePath,
+	// w.SegInfos; // remove merged infos & add new
+	infos := []SegmentInfo{}
+	for i, si := range w.segInfos.segInfos {
+		if i == first {
+			infos = append(infos, seg)
+		}
+		if !merging[si.name] {
+			infos = append(infos, si)
+		}
 	}
 	segs := SegmentInfos{
-		counter:  2,
-		segInfos: []SegmentInfo{seg},
+		counter:  w.segInfos.counter,
+		segInfos: infos,
+	}
+	err := segs.write(w.dir) // commit before deleting, the merged segments stay when it fails
+	if err != nil {
+		return err
 	}
 	w.segInfos = &segs
-
-	w.segInfos.write(w.dir) // commit before deleting
-
-	w.deleteSegments(segsToDelete) // delete now-unused segments
-
 	return nil
 }
 
-//
-func (w *Writer) deleteSegments(segsToDelete []*SegmentReader) error {
+// deleteSegments delete the files of merged segments,
+// a file that can not be deleted yet is kept in the deletable file, to be deleted by a later merge
+func (w *Writer) deleteSegments(segsToDelete []SegmentInfo) error {
 	// get all files should be deleted
 	deleteFiles, err := w.readDeleteableFiles()
 	if err != nil {
 		return err
 	}
+	for _, si := range segsToDelete {
+		files, err := segmentFiles(si.dirPath, si.name)
+		if err != nil {
+			return err
+		}
+		deleteFiles = append(deleteFiles, files...)
+	}
 
 	// get all files can delete, maybe some files can not delete current
 	deleteables, err := w.deleteFiles(deleteFiles)
@@ -229,19 +723,61 @@ func (w *Writer) deleteSegments(segsToDelete []*SegmentReader) error {
 		return err
 	}
 
-	w.writeDeleteableFiles(deleteables)
-	return nil
+	return w.writeDeleteableFiles(deleteables)
+}
+
+// segmentFiles files of a segment
+func segmentFiles(dirPath string, segment string) ([]string, error) {
+	infos, err := ioutil.ReadDir(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	files := []string{}
+	for _, info := range infos {
+		if strings.HasPrefix(info.Name(), segment+".") {
+			files = append(files, path.Join(dirPath, info.Name()))
+		}
+	}
+	return files, nil
 }
 
 // read delete files
 func (w *Writer) readDeleteableFiles() ([]string, error) {
-	return []string{}, nil
+	filePath := path.Join(w.dir.filePath, "deletable")
+	dPtr, err := CreateFile(filePath, false, true)
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer dPtr.close()
+
+	n, err := dPtr.readInt()
+	if err != nil {
+		return nil, err
+	}
+	files := []string{}
+	for i := 0; i < n; i++ {
+		fileName, err := dPtr.readString()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, path.Join(w.dir.filePath, fileName))
+	}
+	return files, nil
 }
 
-// all delete files
+// all delete files, return the files that could not be deleted
 func (w *Writer) deleteFiles(deleteFiles []string) ([]string, error) {
-
-	return []string{}, nil
+	deleteables := []string{}
+	for _, filePath := range deleteFiles {
+		err := os.Remove(filePath)
+		if err != nil && !os.IsNotExist(err) {
+			deleteables = append(deleteables, path.Base(filePath))
+		}
+	}
+	return deleteables, nil
 }
 
 // writeDeleteableFiles
@@ -261,6 +797,5 @@ func (w *Writer) writeDeleteableFiles(deleteables []string) error {
 
 	dPtr.close()
 	nfilepath := path.Join(w.dir.filePath, "deletable")
-	dPtr.rename(nfilepath)
-	return nil
+	return dPtr.rename(nfilepath)
 }
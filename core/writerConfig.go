@@ -0,0 +1,181 @@
+package core
+
+import (
+	"fmt"
+	"io"
+)
+
+/*
+An IndexWriterConfig holds the settings of a writer, so several writers in a process may index with different settings.
+NewIndexWriterConfig returns the default settings, to be changed before the writer is opened with OpenWriter.
+The writer keeps a copy of the config, changing the config afterwards does not change the writer,
+nor does changing the merge policy or merge scheduler, when it is one of this package,
+each writer opened with the config runs its merges with a scheduler of its own, of the same settings.
+A policy or scheduler of another package is shared, such a scheduler must be given to a single writer.
+
+The OpenMode chooses whether a new index is created, replacing the index in the directory,
+an existing index is appended to, or an existing index is appended to and a new one created when there is none.
+
+Buffered documents are written as a new segment once they use RAMBufferSize bytes or number MaxBufferedDocs,
+0 disables a limit, at least one must be set.
+More buffered documents index faster, with fewer merges, at the cost of memory.
+
+MaxFieldLength limits the terms indexed of a field, the later terms of a longer field are dropped.
+IndexInterval is the number of terms between two entries of the term index, .tii,
+a smaller interval finds a term faster with a larger index.
+
+Stored fields are compressed in blocks of documents,
+a block is written once it holds StoredFieldsBlockSize bytes or StoredFieldsBlockDocs documents.
+Larger blocks compress better, but more is decompressed to read a single document.
+
+How often segments are merged is the MergePolicy's, a LogDocMergePolicy by default,
+its MergeFactor segments are merged at once, into at most MaxMergeDocs documents.
+When InfoStream is set, the writer logs its flushes and merges to it.
+*/
+
+// OpenMode how a writer opens its index
+type OpenMode int
+
+const (
+	// OpenModeCreate create a new index, replacing the index of the directory
+	OpenModeCreate OpenMode = iota
+	// OpenModeAppend open an existing index, failing when there is none
+	OpenModeAppend
+	// OpenModeCreateOrAppend open an existing index, or create a new one when there is none
+	OpenModeCreateOrAppend
+)
+
+// Similarity norm of the length of an indexed field
+type Similarity interface {
+	LengthNorm(fieldName string, numTerms int64) byte
+}
+
+// DefaultSimilarity norms of 255 / sqrt(terms), a shorter field scores higher
+type DefaultSimilarity struct{}
+
+// IndexWriterConfig settings of a writer
+type IndexWriterConfig struct {
+	Analyzer       Analyzer       // how to analyze text, may be nil for untokenized fields only
+	Similarity     Similarity     // norms of the indexed fields
+	OpenMode       OpenMode       // create, append or both
+	MergePolicy    MergePolicy    // chooses the segments to merge
+	MergeScheduler MergeScheduler // runs the merges, copied for each writer
+	InfoStream     io.Writer      // log of flushes and merges, nil for none
+
+	AnalyzerRegistry *AnalyzerRegistry // analyzers named by the schema of the index, nil when it names none
+
+	MaxFieldLength  int64 // terms indexed of a field
+	RAMBufferSize   int64 // bytes of buffered documents
+	MaxBufferedDocs int64 // number of buffered documents
+	IndexInterval   int64 // terms between entries of the term index
+
+	StoredFieldsCompression StoredFieldsCodec // codec of the stored fields of new segments
+	StoredFieldsBlockSize   int64             // bytes of a block of stored fields
+	StoredFieldsBlockDocs   int64             // documents of a block of stored fields
+}
+
+// NewIndexWriterConfig default settings, analyzing text with analyzer
+func NewIndexWriterConfig(analyzer Analyzer) *IndexWriterConfig {
+	return &IndexWriterConfig{
+		Analyzer:       analyzer,
+		Similarity:     DefaultSimilarity{},
+		OpenMode:       OpenModeCreateOrAppend,
+		MergePolicy:    NewLogDocMergePolicy(),
+		MergeScheduler: &SerialMergeScheduler{},
+
+		MaxFieldLength:  10000,
+		RAMBufferSize:   16 * 1024 * 1024,
+		MaxBufferedDocs: 0,
+		IndexInterval:   128,
+
+		StoredFieldsCompression: NewDeflateCodec(CompressionFast),
+		StoredFieldsBlockSize:   16 * 1024,
+		StoredFieldsBlockDocs:   128,
+	}
+}
+
+// ================================DefaultSimilarity=======================================
+
+// LengthNorm 255 / sqrt(terms)
+func (s DefaultSimilarity) LengthNorm(fieldName string, numTerms int64) byte {
+	return SimilarityNorm(numTerms)
+}
+
+// ================================IndexWriterConfig=======================================
+
+// clone copy of the config, for a writer, with a copy of the merge policy and a new merge scheduler
+func (c *IndexWriterConfig) clone() *IndexWriterConfig {
+	config := *c
+	config.MergePolicy = copyMergePolicy(c.MergePolicy)
+	config.MergeScheduler = copyMergeScheduler(c.MergeScheduler)
+	return &config
+}
+
+// copyMergePolicy copy of the settings of a merge policy of this package, other policies are kept as they are
+func copyMergePolicy(policy MergePolicy) MergePolicy {
+	switch p := policy.(type) {
+	case *LogDocMergePolicy:
+		c := *p
+		return &c
+	case *LogByteSizeMergePolicy:
+		c := *p
+		return &c
+	case *TieredMergePolicy:
+		c := *p
+		return &c
+	}
+	return policy
+}
+
+// copyMergeScheduler new scheduler of the settings of a merge scheduler of this package, other schedulers are kept as they are
+func copyMergeScheduler(scheduler MergeScheduler) MergeScheduler {
+	switch s := scheduler.(type) {
+	case *SerialMergeScheduler:
+		return &SerialMergeScheduler{}
+	case *ConcurrentMergeScheduler:
+		return &ConcurrentMergeScheduler{MaxThreadCount: s.MaxThreadCount, MaxMergeBytesPerSec: s.MaxMergeBytesPerSec}
+	}
+	return scheduler
+}
+
+// validate check the settings
+func (c *IndexWriterConfig) validate() error {
+	if c.Similarity == nil {
+		return fmt.Errorf("config without similarity")
+	}
+	if c.MergePolicy == nil {
+		return fmt.Errorf("config without merge policy")
+	}
+	if c.MergeScheduler == nil {
+		return fmt.Errorf("config without merge scheduler")
+	}
+	if c.StoredFieldsCompression == nil {
+		return fmt.Errorf("config without stored fields compression")
+	}
+	if c.OpenMode < OpenModeCreate || c.OpenMode > OpenModeCreateOrAppend {
+		return fmt.Errorf("unknown open mode %d", c.OpenMode)
+	}
+	if c.MaxFieldLength < 1 {
+		return fmt.Errorf("max field length %d, must be at least 1", c.MaxFieldLength)
+	}
+	if c.RAMBufferSize < 0 || c.MaxBufferedDocs < 0 {
+		return fmt.Errorf("negative ram buffer size or max buffered docs")
+	}
+	if c.RAMBufferSize == 0 && c.MaxBufferedDocs == 0 {
+		return fmt.Errorf("ram buffer size and max buffered docs both disabled, documents would never be flushed")
+	}
+	if c.IndexInterval < 1 {
+		return fmt.Errorf("index interval %d, must be at least 1", c.IndexInterval)
+	}
+	if c.StoredFieldsBlockSize < 1 || c.StoredFieldsBlockDocs < 1 {
+		return fmt.Errorf("stored fields blocks of %d bytes, %d documents, must be at least 1",
+			c.StoredFieldsBlockSize, c.StoredFieldsBlockDocs)
+	}
+	if policy, ok := c.MergePolicy.(*LogDocMergePolicy); ok && policy.MergeFactor < 2 {
+		return fmt.Errorf("merge factor %d, must be at least 2", policy.MergeFactor)
+	}
+	if policy, ok := c.MergePolicy.(*LogByteSizeMergePolicy); ok && policy.MergeFactor < 2 {
+		return fmt.Errorf("merge factor %d, must be at least 2", policy.MergeFactor)
+	}
+	return nil
+}
@@ -0,0 +1,583 @@
+package test
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/Kua-Fu/gsearch/core"
+)
+
+// tokenTexts analyze text and return term texts with their increments
+func tokenTexts(t *testing.T, analyzer core.Analyzer, text string) ([]string, []int64, []int64) {
+	tokens, err := core.TokenSlice(analyzer, "text", text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		texts   []string
+		incs    []int64
+		lengths []int64
+	)
+	for _, token := range tokens {
+		texts = append(texts, token.TermText)
+		incs = append(incs, token.PositionIncrement)
+		lengths = append(lengths, token.PositionLength)
+	}
+	return texts, incs, lengths
+}
+
+func TestStandardTokenizer(t *testing.T) {
+	texts, _, _ := tokenTexts(t, core.StandardAnalyzer{}, "床前明月光, Li Bai 701")
+	want := "床 前 明 月 光 li bai 701"
+	if strings.Join(texts, " ") != want {
+		t.Errorf("got %v, want %s", texts, want)
+	}
+}
+
+func TestSynonymGraph(t *testing.T) {
+	synonyms := core.NewSynonymMap(core.StandardAnalyzer{}, true)
+	err := synonyms.ReadSolr(strings.NewReader("# places\n长安, 京城\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	graph := &core.CustomAnalyzer{
+		Filters: []core.TokenFilterFactory{
+			func(input core.TokenStream) core.TokenStream { return core.NewLowerCaseFilter(input) },
+			func(input core.TokenStream) core.TokenStream { return core.NewSynonymFilter(input, synonyms) },
+		},
+	}
+	texts, incs, lengths := tokenTexts(t, graph, "望长安")
+	if strings.Join(texts, " ") != "望 长 京 安 城" {
+		t.Errorf("graph texts %v", texts)
+	}
+	wantIncs := []int64{1, 1, 0, 1, 1}
+	wantLengths := []int64{1, 1, 2, 2, 1}
+	for i := range wantIncs {
+		if incs[i] != wantIncs[i] || lengths[i] != wantLengths[i] {
+			t.Errorf("token %d: inc %d len %d", i, incs[i], lengths[i])
+		}
+	}
+
+	flat := &core.CustomAnalyzer{
+		Filters: append(graph.Filters, func(input core.TokenStream) core.TokenStream {
+			return core.NewFlattenGraphFilter(input)
+		}),
+	}
+	texts, incs, _ = tokenTexts(t, flat, "望长安")
+	wantIncs = []int64{1, 1, 0, 1, 0}
+	for i := range wantIncs {
+		if incs[i] != wantIncs[i] {
+			t.Errorf("flat token %s: inc %d", texts[i], incs[i])
+		}
+	}
+}
+
+func TestWordNetSynonyms(t *testing.T) {
+	dir, err := ioutil.TempDir("", "synonyms")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filePath := path.Join(dir, "wn_s.pl")
+	data := "s(100000001,1,'li bai',n,1,0).\ns(100000001,2,'taibai',n,1,0).\n"
+	err = ioutil.WriteFile(filePath, []byte(data), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	synonyms, err := core.LoadSynonymFile(filePath, "wordnet", core.StandardAnalyzer{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	analyzer := &core.CustomAnalyzer{
+		Filters: []core.TokenFilterFactory{
+			func(input core.TokenStream) core.TokenStream { return core.NewLowerCaseFilter(input) },
+			func(input core.TokenStream) core.TokenStream { return core.NewSynonymFilter(input, synonyms) },
+		},
+	}
+	texts, _, _ := tokenTexts(t, analyzer, "Taibai")
+	if strings.Join(texts, " ") != "li bai" {
+		t.Errorf("got %v", texts)
+	}
+}
+
+func TestEdgeNGram(t *testing.T) {
+	edge, err := core.EdgeNGramFilterFactory(1, 3, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	analyzer := &core.CustomAnalyzer{
+		Tokenizer: func(reader io.Reader) core.TokenStream { return core.NewKeywordTokenizer(reader) },
+		Filters:   []core.TokenFilterFactory{edge},
+	}
+	texts, incs, _ := tokenTexts(t, analyzer, "春江花月夜")
+	if strings.Join(texts, " ") != "春 春江 春江花 春江花月夜" {
+		t.Errorf("got %v", texts)
+	}
+	if incs[0] != 1 || incs[1] != 0 {
+		t.Errorf("grams must stack on the token position: %v", incs)
+	}
+
+	_, err = core.NGramFilterFactory(3, 2, false)
+	if err == nil {
+		t.Errorf("expected an error for minGram > maxGram")
+	}
+	gram, _ := core.NGramFilterFactory(2, 2, false)
+	analyzer.Filters = []core.TokenFilterFactory{gram}
+	texts, _, _ = tokenTexts(t, analyzer, "明月光")
+	if strings.Join(texts, " ") != "明月 月光" {
+		t.Errorf("got %v", texts)
+	}
+}
+
+func TestPinyinFilter(t *testing.T) {
+	analyzer := &core.CustomAnalyzer{
+		Tokenizer: func(reader io.Reader) core.TokenStream { return core.NewKeywordTokenizer(reader) },
+		Filters: []core.TokenFilterFactory{
+			func(input core.TokenStream) core.TokenStream {
+				return core.NewPinyinFilter(input, core.PinyinBoth, false, true)
+			},
+		},
+	}
+	texts, incs, _ := tokenTexts(t, analyzer, "李白")
+	if strings.Join(texts, " ") != "李白 libai lb" {
+		t.Errorf("got %v", texts)
+	}
+	if incs[1] != 0 || incs[2] != 0 {
+		t.Errorf("pinyin must stack on the original: %v", incs)
+	}
+
+	texts, _, _ = tokenTexts(t, analyzer, "长安")
+	if texts[1] != "changan" {
+		t.Errorf("polyphonic word read as %s", texts[1])
+	}
+
+	analyzer.Filters = []core.TokenFilterFactory{
+		func(input core.TokenStream) core.TokenStream {
+			return core.NewPinyinFilter(input, core.PinyinFull, true, false)
+		},
+	}
+	texts, _, _ = tokenTexts(t, analyzer, "绿")
+	if strings.Join(texts, " ") != "lǜ" {
+		t.Errorf("got %v", texts)
+	}
+}
+
+func TestChineseVariantCharFilter(t *testing.T) {
+	analyzer := &core.CustomAnalyzer{
+		CharFilters: []core.CharFilterFactory{
+			func(reader io.Reader) core.CharFilter { return core.NewChineseVariantCharFilter(reader) },
+		},
+	}
+	texts, _, _ := tokenTexts(t, analyzer, "駿馬登峯")
+	if strings.Join(texts, "") != "骏马登峰" {
+		t.Errorf("got %v", texts)
+	}
+
+	mapping := map[string]string{"ae": "æ", "ß": "ss"}
+	analyzer.CharFilters = []core.CharFilterFactory{
+		func(reader io.Reader) core.CharFilter { return core.NewMappingCharFilter(reader, mapping) },
+	}
+	text := "aegis straße end"
+	tokens, err := core.TokenSlice(analyzer, "text", text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"aegis", "straße", "end"}
+	for i, token := range tokens {
+		if original := text[token.StartOffset:token.EndOffset]; original != want[i] {
+			t.Errorf("token %s points at %q", token.TermText, original)
+		}
+	}
+}
+
+func TestNormalizerFilters(t *testing.T) {
+	analyzer := &core.CustomAnalyzer{
+		Tokenizer: func(reader io.Reader) core.TokenStream { return core.NewWhitespaceTokenizer(reader) },
+		Filters: []core.TokenFilterFactory{
+			func(input core.TokenStream) core.TokenStream { return core.NewICUNormalizerFilter(input) },
+		},
+	}
+	texts, _, _ := tokenTexts(t, analyzer, "Ｌｉ Ｂａｉ ﬁne Straße \uF900 Cafe\u0301")
+	if strings.Join(texts, " ") != "li bai fine strasse \u8C48 café" {
+		t.Errorf("got %v", texts)
+	}
+
+	analyzer.Filters = []core.TokenFilterFactory{
+		func(input core.TokenStream) core.TokenStream { return core.NewASCIIFoldingFilter(input, true) },
+	}
+	texts, incs, _ := tokenTexts(t, analyzer, "Dù Fǔ æon")
+	if strings.Join(texts, " ") != "Dù Du Fǔ Fu æon aeon" {
+		t.Errorf("got %v", texts)
+	}
+	if incs[1] != 0 {
+		t.Errorf("folded token must stack on the original: %v", incs)
+	}
+}
+
+func TestStripCharFilters(t *testing.T) {
+	analyzer := &core.CustomAnalyzer{
+		CharFilters: []core.CharFilterFactory{
+			func(reader io.Reader) core.CharFilter { return core.NewHTMLStripCharFilter(reader) },
+		},
+		Filters: []core.TokenFilterFactory{
+			func(input core.TokenStream) core.TokenStream { return core.NewLowerCaseFilter(input) },
+		},
+	}
+	text := "<html><head><style>p { color: red }</style><script>var moon = 1;</script></head>" +
+		"<body><h1>Quiet Night</h1><!-- by li bai --><p>bright&nbsp;moonlight&#44; frost</p><br/>caf&eacute;</body></html>"
+	tokens, err := core.TokenSlice(analyzer, "text", text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"quiet", "night", "bright", "moonlight", "frost", "café"}
+	original := []string{"Quiet", "Night", "bright", "moonlight", "frost", "caf&eacute;"}
+	if len(tokens) != len(want) {
+		t.Fatalf("got %v", tokens)
+	}
+	for i, token := range tokens {
+		if token.TermText != want[i] || text[token.StartOffset:token.EndOffset] != original[i] {
+			t.Errorf("token %s points at %q", token.TermText, text[token.StartOffset:token.EndOffset])
+		}
+	}
+
+	// lower casing changes the byte length of Ⱥ and İ, the closing tag is found in the original text
+	for _, c := range []string{"Ⱥ", "İ"} {
+		text = "<script>" + strings.Repeat(c, 100) + "</SCRIPT> hello"
+		tokens, err = core.TokenSlice(analyzer, "text", text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(tokens) != 1 || tokens[0].TermText != "hello" || text[tokens[0].StartOffset:tokens[0].EndOffset] != "hello" {
+			t.Errorf("script of %s: got %v", c, tokens)
+		}
+	}
+
+	analyzer.CharFilters = []core.CharFilterFactory{
+		func(reader io.Reader) core.CharFilter { return core.NewMarkdownStripCharFilter(reader) },
+	}
+	text = "# Quiet *Night*\n\n> bright [moon](https://example.com/moon) \\*light\n\n```\nfrost_on ground\n```\n- [1]: https://example.com\n"
+	tokens, err = core.TokenSlice(analyzer, "text", text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	texts := []string{}
+	for _, token := range tokens {
+		texts = append(texts, token.TermText)
+		if token.StartOffset < 0 || token.EndOffset > int64(len(text)) {
+			t.Fatalf("token %s offsets %d-%d out of range", token.TermText, token.StartOffset, token.EndOffset)
+		}
+	}
+	if strings.Join(texts, " ") != "quiet night bright moon light frost on ground" {
+		t.Errorf("got %v", texts)
+	}
+	if moon := tokens[3]; text[moon.StartOffset:moon.EndOffset] != "moon" {
+		t.Errorf("moon points at %q", text[moon.StartOffset:moon.EndOffset])
+	}
+}
+
+// sliceStream token stream over fixed tokens
+type sliceStream struct {
+	tokens []core.Token
+}
+
+func (ss *sliceStream) Next() (*core.Token, error) {
+	if len(ss.tokens) == 0 {
+		return nil, nil
+	}
+	t := ss.tokens[0]
+	ss.tokens = ss.tokens[1:]
+	return &t, nil
+}
+
+func (ss *sliceStream) Close() error {
+	return nil
+}
+
+func TestShingleFilter(t *testing.T) {
+	shingles, err := core.ShingleFilterFactory(2, 3, " ", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	analyzer := &core.CustomAnalyzer{
+		Tokenizer: func(reader io.Reader) core.TokenStream { return core.NewWhitespaceTokenizer(reader) },
+		Filters:   []core.TokenFilterFactory{shingles},
+	}
+	texts, incs, lengths := tokenTexts(t, analyzer, "明月 光 照")
+	want := []string{"明月", "明月 光", "明月 光 照", "光", "光 照", "照"}
+	if strings.Join(texts, "|") != strings.Join(want, "|") {
+		t.Fatalf("got %v", texts)
+	}
+	if incs[1] != 0 || incs[3] != 1 || lengths[2] != 3 {
+		t.Errorf("increments %v, lengths %v", incs, lengths)
+	}
+	tokens, err := core.TokenSlice(analyzer, "text", "明月 光 照")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tokens[2].StartOffset != 0 || tokens[2].EndOffset != int64(len("明月 光 照")) {
+		t.Errorf("shingle offsets %d-%d", tokens[2].StartOffset, tokens[2].EndOffset)
+	}
+
+	// a removed word leaves a gap that is filled
+	input := &sliceStream{tokens: []core.Token{
+		{TermText: "to", EndOffset: 2, PositionIncrement: 1},
+		{TermText: "be", StartOffset: 3, EndOffset: 5, PositionIncrement: 1},
+		{TermText: "not", StartOffset: 9, EndOffset: 12, PositionIncrement: 2},
+	}}
+	sf, err := core.NewShingleFilter(input, 2, 3, "_", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := []string{}
+	incs = []int64{}
+	for {
+		token, err := sf.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if token == nil {
+			break
+		}
+		got = append(got, token.TermText)
+		incs = append(incs, token.PositionIncrement)
+	}
+	if strings.Join(got, " ") != "to_be be___not" {
+		t.Errorf("got %v", got)
+	}
+	if len(incs) != 2 || incs[0] != 1 || incs[1] != 1 {
+		t.Errorf("increments %v", incs)
+	}
+
+	if _, err := core.ShingleFilterFactory(1, 2, " ", true); err == nil {
+		t.Errorf("expected an error for a shingle size of one")
+	}
+}
+
+func TestAnalyzerRegistry(t *testing.T) {
+	config := `{
+		"analyzers": {
+			"poem": {
+				"char_filters": ["html_strip"],
+				"tokenizer": "whitespace",
+				"filters": ["lowercase", {"type": "shingle", "min_size": 2, "max_size": 2, "output_unigrams": false}]
+			},
+			"title": {
+				"filters": ["lowercase", {"type": "synonym", "synonyms": ["moon, luna"]}, "flatten_graph"]
+			}
+		},
+		"default": "standard",
+		"fields": {"body": "poem", "title": "title", "author": "keyword"}
+	}`
+	registry := core.NewAnalyzerRegistry()
+	analyzer, err := registry.Load(strings.NewReader(config))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		field string
+		text  string
+		want  string
+	}{
+		{"body", "<p>Bright Moon</p> Light", "bright moon|moon light"},
+		{"title", "Moon", "moon|luna"},
+		{"author", "Li Bai", "Li Bai"},
+		{"other", "Li Bai", "li|bai"},
+	}
+	for _, c := range cases {
+		tokens, err := core.TokenSlice(analyzer, c.field, c.text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		texts := []string{}
+		for _, token := range tokens {
+			texts = append(texts, token.TermText)
+		}
+		if strings.Join(texts, "|") != c.want {
+			t.Errorf("%s: got %v", c.field, texts)
+		}
+	}
+	if _, err := registry.Analyzer("poem"); err != nil {
+		t.Error(err)
+	}
+
+	for _, bad := range []string{
+		`{"analyzers": {"a": {"tokenizer": "nope"}}}`,
+		`{"analyzers": {"a": {"filters": [{"type": "ngram", "min_gram": 3, "max_gram": 2}]}}}`,
+		`{"fields": {"title": "missing"}}`,
+		`{"analyzers": {"a": {"filters": [{"type": "ngram", "min_gramm": 3}]}}}`,
+		`{"analyzers": {"a": {"filters": [{"type": "lowercase", "max_gram": 3}]}}}`,
+		`{"analyzers": {"a": {"filters": [{"type": "synonym", "analyzer": "b", "synonyms": ["x, y"]}]},
+			"b": {"filters": [{"type": "synonym", "analyzer": "a", "synonyms": ["x, y"]}]}}}`,
+	} {
+		if _, err := core.NewAnalyzerRegistry().Load(strings.NewReader(bad)); err == nil {
+			t.Errorf("expected an error for %s", bad)
+		}
+	}
+
+	// nothing is registered by a failed config
+	registry = core.NewAnalyzerRegistry()
+	_, err = registry.Load(strings.NewReader(`{"analyzers": {"a": {"tokenizer": "whitespace"}, "b": {"tokenizer": "nope"}}}`))
+	if err == nil {
+		t.Fatal("expected an error for tokenizer nope")
+	}
+	if _, err = registry.Analyzer("a"); err == nil {
+		t.Error("analyzer a registered by a failed config")
+	}
+
+	// a synonym filter parsing its rules with an analyzer sorted after it
+	registry = core.NewAnalyzerRegistry()
+	analyzer, err = registry.Load(strings.NewReader(`{
+		"analyzers": {
+			"a": {"tokenizer": "whitespace", "filters": [{"type": "synonym", "analyzer": "z", "synonyms": ["Moon, Luna"]}]},
+			"z": {"tokenizer": "whitespace"}
+		},
+		"default": "a"
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tokens, err := core.TokenSlice(analyzer, "title", "Moon")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tokens) != 2 || tokens[1].TermText != "Luna" {
+		t.Errorf("got %v", tokens)
+	}
+}
+
+func TestPhoneticFilter(t *testing.T) {
+	encodings := []struct {
+		encoder core.PhoneticEncoder
+		word    string
+		want    string
+	}{
+		{core.Soundex{}, "Robert", "R163"},
+		{core.Soundex{}, "Rupert", "R163"},
+		{core.Soundex{}, "Ashcraft", "A261"},
+		{core.Soundex{}, "Tymczak", "T522"},
+		{core.Metaphone{}, "Knight", "NT"},
+		{core.Metaphone{}, "Thomas", "0MS"},
+		{core.DoubleMetaphone{}, "Schmidt", "XMT SMT"},
+		{core.DoubleMetaphone{}, "Smith", "SM0 XMT"},
+		{core.DoubleMetaphone{}, "Zhang", "JNK"},
+		{core.DoubleMetaphone{}, "Michael", "MKL MXL"},
+		{core.DoubleMetaphone{}, "李白", ""},
+	}
+	for _, e := range encodings {
+		if got := strings.Join(e.encoder.Encode(e.word), " "); got != e.want {
+			t.Errorf("%T %s: got %q, want %q", e.encoder, e.word, got, e.want)
+		}
+	}
+
+	registry := core.NewAnalyzerRegistry()
+	_, err := registry.Load(strings.NewReader(`{"analyzers": {"name": {"filters": [{"type": "phonetic", "encoder": "double_metaphone"}]}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	analyzer, err := registry.Analyzer("name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	texts, incs, _ := tokenTexts(t, analyzer, "Wang Wei 王维")
+	if strings.Join(texts, " ") != "Wang ANK FNK Wei A F 王 维" {
+		t.Errorf("got %v", texts)
+	}
+	if incs[1] != 0 || incs[2] != 0 || incs[3] != 1 {
+		t.Errorf("increments %v", incs)
+	}
+	query, _, _ := tokenTexts(t, &core.CustomAnalyzer{
+		Filters: []core.TokenFilterFactory{core.PhoneticFilterFactory(core.DoubleMetaphone{}, false)},
+	}, "Vang Vey")
+	if strings.Join(query, " ") != "FNK F" {
+		t.Errorf("got %v", query)
+	}
+}
+
+func TestLanguageAnalyzer(t *testing.T) {
+	identifier := core.DefaultLanguageIdentifier()
+	for text, want := range map[string]string{
+		"床前明月光，疑是地上霜":                     "zh",
+		"春眠暁を覚えず、処処に啼鳥を聞く":                "ja",
+		"Quiet night thoughts":            "en",
+		"Gedanken in einer stillen Nacht": "de",
+		"고요한 밤의 생각":                       "ko",
+	} {
+		if got, _ := identifier.Detect(text); got != want {
+			t.Errorf("%s: got %s, want %s", text, got, want)
+		}
+	}
+	if got, _ := identifier.Detect("1234 !?"); got != "" {
+		t.Errorf("got %s for a text without letters", got)
+	}
+
+	pinyin := &core.CustomAnalyzer{
+		Filters: []core.TokenFilterFactory{
+			func(input core.TokenStream) core.TokenStream {
+				return core.NewPinyinFilter(input, core.PinyinFull, false, false)
+			},
+		},
+	}
+	analyzer := core.NewLanguageAnalyzer(nil, core.StandardAnalyzer{}, map[string]core.Analyzer{"zh": pinyin})
+	texts, _, _ := tokenTexts(t, analyzer, "明月")
+	if strings.Join(texts, " ") != "ming yue" {
+		t.Errorf("got %v", texts)
+	}
+	texts, _, _ = tokenTexts(t, analyzer, "Bright Moon")
+	if strings.Join(texts, " ") != "bright moon" {
+		t.Errorf("got %v", texts)
+	}
+	if analyzer.ForLanguage("zh") != pinyin || analyzer.ForLanguage("fr") != (core.StandardAnalyzer{}) {
+		t.Errorf("declared language picks the wrong analyzer")
+	}
+
+	// detection settings of one analyzer leave the others alone
+	short := core.NewLanguageAnalyzer(nil, core.StandardAnalyzer{}, map[string]core.Analyzer{"zh": pinyin})
+	short.DetectBytes = 6 // "Moon 明", cut in the middle of 明
+	texts, _, _ = tokenTexts(t, short, "Moon 床前明月光")
+	if strings.Join(texts, " ") != "moon 床 前 明 月 光" {
+		t.Errorf("got %v", texts)
+	}
+	texts, _, _ = tokenTexts(t, analyzer, "Moon 床前明月光")
+	if strings.Join(texts, " ") != "Moon chuang qian ming yue guang" {
+		t.Errorf("got %v", texts)
+	}
+	short.DetectBytes = 3 // "床", a whole rune kept
+	texts, _, _ = tokenTexts(t, short, "床前明月光")
+	if strings.Join(texts, " ") != "chuang qian ming yue guang" {
+		t.Errorf("got %v", texts)
+	}
+	for _, detectBytes := range []int{0, -1} { // the default
+		short.DetectBytes = detectBytes
+		texts, _, _ = tokenTexts(t, short, "Moon 床前明月光")
+		if strings.Join(texts, " ") != "Moon chuang qian ming yue guang" {
+			t.Errorf("detect bytes %d: got %v", detectBytes, texts)
+		}
+	}
+
+	doc := core.Document{}
+	for _, value := range []string{"静夜思", "Quiet Night Thoughts"} {
+		f, _ := core.Text("text", value)
+		doc.Add(f)
+	}
+	err := analyzer.TagLanguage(&doc, "text", "lang")
+	if err != nil {
+		t.Fatal(err)
+	}
+	languages := []string{}
+	for _, f := range doc.Fields {
+		if f.Name() == "lang" {
+			languages = append(languages, f.StringValue())
+		}
+	}
+	if strings.Join(languages, " ") != "zh en" {
+		t.Errorf("got %v", languages)
+	}
+}
@@ -0,0 +1,251 @@
+package test
+
+import (
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/Kua-Fu/gsearch/core"
+)
+
+// parityPoem poem number i, even or odd
+func parityPoem(i int) core.Document {
+	parity := "odd"
+	if i%2 == 0 {
+		parity = "even"
+	}
+	doc := core.Document{}
+	f, _ := core.Keyword("id", strconv.Itoa(i))
+	doc.Add(f)
+	f, _ = core.Text("title", "poem "+parity)
+	doc.Add(f)
+	return doc
+}
+
+func TestDeleteDocuments(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	config := core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	config.MaxBufferedDocs = 5 // segments of 5, too few to merge
+	writer, err := core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 21; i++ { // 20 is still buffered
+		writer.AddDocument(parityPoem(i))
+	}
+	if writer.DeleteDocuments(core.NewTerm("", "3")) == nil || writer.DeleteByQuery(core.NewTermQuery("title", "odd"), nil) == nil {
+		t.Fatal("delete by a term without field or a nil query accepted")
+	}
+	err = writer.DeleteDocuments(core.NewTerm("id", "3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = writer.DeleteByQuery(core.NewTermQuery("title", "even"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	writer.AddDocument(parityPoem(22)) // added after, kept
+	err = writer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if writer.DeleteDocuments(core.NewTerm("id", "5")) == nil {
+		t.Fatal("delete buffered by a closed writer")
+	}
+
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reader.MaxDoc() != 22 || reader.NumDocs() != 10 || !reader.HasDeletions() {
+		t.Fatalf("got max doc %d, num docs %d", reader.MaxDoc(), reader.NumDocs())
+	}
+	termDocs, err := reader.TermDocs("title", "even")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(termDocs) != 1 || termDocs[0].Doc != 21 {
+		t.Fatalf("got even docs %v, want the one added after the delete", termDocs)
+	}
+	if !reader.IsDeleted(3) || reader.IsDeleted(5) {
+		t.Fatal("wrong documents deleted")
+	}
+	if _, err = reader.Document(3); err == nil {
+		t.Fatal("read a deleted document")
+	}
+	top, err := core.NewIndexSearcher(reader).Search(core.NewTermQuery("title", "poem"), 100)
+	if err != nil || top.TotalHits != 10 {
+		t.Fatalf("got %v hits, %v", top.TotalHits, err)
+	}
+	reader.Close()
+
+	// optimize drops the deleted documents
+	config = core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	writer, err = core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = writer.Optimize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	writer.Close()
+	reader, err = core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	if len(reader.Segments()) != 1 || reader.MaxDoc() != 10 || reader.NumDocs() != 10 {
+		t.Fatalf("got %d segments, max doc %d, num docs %d", len(reader.Segments()), reader.MaxDoc(), reader.NumDocs())
+	}
+	ids := []string{}
+	for n := int64(0); n < reader.MaxDoc(); n++ {
+		doc, err := reader.Document(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, doc.GetValues("id")[0])
+		termDocs, err := reader.TermDocs("id", doc.GetValues("id")[0])
+		if err != nil || len(termDocs) != 1 || termDocs[0].Doc != n {
+			t.Fatalf("doc %d: got term docs %v, %v", n, termDocs, err)
+		}
+	}
+	if strings.Join(ids, " ") != "1 5 7 9 11 13 15 17 19 22" {
+		t.Fatalf("got ids %v", ids)
+	}
+	if reader.DocFreq("title", "odd") != 9 || reader.DocFreq("title", "even") != 1 {
+		t.Fatal("deleted documents left in the postings")
+	}
+	files, err := ioutil.ReadDir(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		if strings.HasSuffix(file.Name(), ".del") {
+			t.Fatalf("del file %s left", file.Name())
+		}
+	}
+}
+
+func TestDeleteWhileMerging(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	scheduler := core.NewConcurrentMergeScheduler()
+	scheduler.MaxMergeBytesPerSec = 64 * 1024 // merges run while deleting
+	config := core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	config.MaxBufferedDocs = 2
+	config.MergePolicy = &core.LogDocMergePolicy{MergeFactor: 3, MaxMergeDocs: 1 << 30}
+	config.MergeScheduler = scheduler
+	writer, err := core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const n = 90
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i++ {
+			if err := writer.AddDocument(parityPoem(i)); err != nil {
+				t.Error(err)
+				return
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		for i := 0; i < n; i = i + 3 {
+			writer.DeleteDocuments(core.NewTerm("id", strconv.Itoa(i)))
+			writer.Flush()
+		}
+	}()
+	wg.Wait()
+	for i := 0; i < n; i = i + 3 { // those added after their delete
+		writer.DeleteDocuments(core.NewTerm("id", strconv.Itoa(i)))
+	}
+	err = writer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	if reader.NumDocs() != n-n/3 {
+		t.Fatalf("got %d docs, want %d", reader.NumDocs(), n-n/3)
+	}
+	for i := 0; i < n; i++ {
+		termDocs, err := reader.TermDocs("id", strconv.Itoa(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := 1; i%3 == 0 && len(termDocs) != 0 || i%3 != 0 && len(termDocs) != want {
+			t.Fatalf("id %d: got term docs %v", i, termDocs)
+		}
+	}
+}
+
+func TestDeleteBlockParent(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	writer, err := core.OpenWriter(indexDir, core.NewIndexWriterConfig(core.StandardAnalyzer{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, block := range [][]string{{"moon", "A"}, {"sun", "B"}} {
+		child := core.Document{}
+		f, _ := core.Text("t", block[0])
+		child.Add(f)
+		parent := core.Document{}
+		f, _ = core.Keyword("name", block[1])
+		parent.Add(f)
+		f, _ = core.Keyword("type", "parent")
+		parent.Add(f)
+		err = writer.AddDocuments([]core.Document{child, parent})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	writer.DeleteDocuments(core.NewTerm("name", "A")) // the parent only
+	err = writer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	parents := core.NewQueryBitSetProducer(core.NewTermQuery("type", "parent"))
+	hits, err := core.NewToParentBlockJoinQuery(core.NewTermQuery("t", "moon"), parents, core.ScoreModeNone).Matches(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hits) != 0 {
+		t.Fatalf("orphan joined parent %v", hits)
+	}
+	hits, err = core.NewToParentBlockJoinQuery(core.NewTermQuery("t", "sun"), parents, core.ScoreModeNone).Matches(reader)
+	if err != nil || len(hits) != 1 || hits[0].Doc != 3 {
+		t.Fatalf("got %v, %v", hits, err)
+	}
+}
@@ -0,0 +1,281 @@
+package test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Kua-Fu/gsearch/core"
+)
+
+// closeRecorder reader that records whether it was closed
+type closeRecorder struct {
+	*strings.Reader
+	closed bool
+}
+
+func (cr *closeRecorder) Close() error {
+	cr.closed = true
+	return nil
+}
+
+func TestReaderField(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	anthology := &closeRecorder{Reader: strings.NewReader(strings.Repeat("bright moonlight frost ", 100))}
+	body, err := core.TextReader("body", anthology)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body.ReaderValue() == nil || body.StringValue() != "" {
+		t.Errorf("reader field has no reader")
+	}
+	title, _ := core.Text("title", "quiet night")
+
+	doc := core.Document{}
+	doc.Add(title)
+	doc.Add(body)
+
+	writer := new(core.DocumentWriter)
+	writer.Init(indexDir, core.StandardAnalyzer{}, 10000)
+	_, err = writer.AddDocument("s1", doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !anthology.closed {
+		t.Errorf("reader not closed")
+	}
+
+	terms, err := ioutil.ReadFile(path.Join(indexDir, "s1.tis"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(terms, []byte("moonlight")) {
+		t.Errorf("reader field not indexed")
+	}
+	stored, err := ioutil.ReadFile(path.Join(indexDir, "s1.fdt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Contains(stored, []byte("moonlight")) || !bytes.Contains(stored, []byte("quiet night")) {
+		t.Errorf("reader field stored")
+	}
+
+	if _, err := core.TextReader("body", nil); err == nil {
+		t.Errorf("expected an error for a nil reader")
+	}
+}
+
+func TestBinaryField(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	thumb := make([]byte, 300) // longer than one varint byte, with every byte value
+	for i := range thumb {
+		thumb[i] = byte(i)
+	}
+
+	writer := new(core.Writer)
+	writer.Init(indexDir, core.StandardAnalyzer{}, true)
+	for _, title := range []string{"quiet night", "spring dawn"} {
+		doc := core.Document{}
+		f1, _ := core.Text("title", title)
+		f2, _ := core.Binary("thumb", thumb)
+		doc.Add(f1)
+		doc.Add(f2)
+		writer.AddDocument(doc)
+	}
+	writer.Close()
+
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	if reader.MaxDoc() != 2 || reader.NumDocs() != 2 {
+		t.Fatalf("got %d docs, want 2", reader.MaxDoc())
+	}
+	doc, err := reader.Document(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Fields) != 2 {
+		t.Fatalf("got %d fields, want 2", len(doc.Fields))
+	}
+	title, thumbField := doc.Fields[0], doc.Fields[1]
+	if title.IsBinary() || title.StringValue() != "spring dawn" {
+		t.Errorf("title = %q", title.StringValue())
+	}
+	if !thumbField.IsBinary() || !bytes.Equal(thumbField.BinaryValue(), thumb) {
+		t.Errorf("thumb = %v", thumbField.BinaryValue())
+	}
+	if _, err := reader.Document(2); err == nil {
+		t.Errorf("expected an error past the last document")
+	}
+}
+
+func TestMultiValuedField(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	doc := core.Document{}
+	for _, line := range []string{"bright moon", "moon frost"} {
+		f, _ := core.Text("paragraphs", line)
+		doc.Add(f)
+	}
+
+	// positions of bright, frost and moon, each delta encoded in one byte
+	cases := []struct {
+		gap  int64
+		want []byte
+	}{
+		{0, []byte{0, 3, 1, 1}},       // bright 0, frost 3, moon 1 2
+		{100, []byte{0, 103, 1, 101}}, // bright 0, frost 103, moon 1 102
+	}
+	for _, c := range cases {
+		analyzer := &core.CustomAnalyzer{PositionGap: c.gap}
+		writer := new(core.DocumentWriter)
+		writer.Init(indexDir, analyzer, 10000)
+		segment := "gap" + strconv.FormatInt(c.gap, 10)
+		_, err = writer.AddDocument(segment, doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		positions, err := ioutil.ReadFile(path.Join(indexDir, segment+".prx"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(positions, c.want) {
+			t.Errorf("gap %d: positions %v, want %v", c.gap, positions, c.want)
+		}
+	}
+
+	wrapper := core.NewPerFieldAnalyzerWrapper(core.StandardAnalyzer{}, map[string]core.Analyzer{
+		"paragraphs": &core.CustomAnalyzer{PositionGap: 100},
+	})
+	if wrapper.PositionIncrementGap("paragraphs") != 100 || wrapper.PositionIncrementGap("title") != 0 {
+		t.Errorf("per field gaps %d %d", wrapper.PositionIncrementGap("paragraphs"), wrapper.PositionIncrementGap("title"))
+	}
+
+	// every stored value comes back, in order
+	storeDir := path.Join(indexDir, "stored")
+	err = os.Mkdir(storeDir, 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writer := new(core.Writer)
+	err = writer.Init(storeDir, wrapper, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writer.AddDocument(doc)
+	writer.Close()
+
+	reader, err := core.OpenIndexReader(storeDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	stored, err := reader.Document(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	values := stored.GetValues("paragraphs")
+	if len(values) != 2 || values[0] != "bright moon" || values[1] != "moon frost" {
+		t.Errorf("stored values %q", values)
+	}
+
+	// a doc values field of the same name has no string value
+	doc = core.Document{}
+	f, _ := core.Keyword("dynasty", "tang")
+	doc.Add(f)
+	f, _ = core.SortedDocValuesField("dynasty", "tang")
+	doc.Add(f)
+	f, _ = core.NumericDocValuesField("year", 726)
+	doc.Add(f)
+	if values := doc.GetValues("dynasty"); len(values) != 1 || values[0] != "tang" {
+		t.Errorf("dynasty values %q", values)
+	}
+	if values := doc.GetValues("year"); len(values) != 0 || len(doc.GetFields("year")) != 1 {
+		t.Errorf("year values %q", values)
+	}
+}
+
+func TestStoredFieldsCompression(t *testing.T) {
+	line := "before my bed the moonlight is so bright that I wonder if it is frost upon the ground "
+	fdtSize := map[string]int64{}
+	for name, codec := range map[string]core.StoredFieldsCodec{
+		"none": core.NoCompressionCodec{},
+		"fast": core.NewDeflateCodec(core.CompressionFast),
+		"high": core.NewDeflateCodec(core.CompressionHigh),
+	} {
+		indexDir, err := ioutil.TempDir("", "gsearch")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(indexDir)
+
+		config := core.NewIndexWriterConfig(core.StandardAnalyzer{})
+		config.StoredFieldsCompression = codec
+		config.StoredFieldsBlockDocs = 4 // several blocks
+		writer, err := core.OpenWriter(indexDir, config)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 10; i++ {
+			doc := core.Document{}
+			body, _ := core.UnIndexed("body", strings.Repeat(line, i+1))
+			thumb, _ := core.Binary("thumb", []byte{byte(i)})
+			doc.Add(body)
+			doc.Add(thumb)
+			writer.AddDocument(doc)
+		}
+		writer.Close()
+
+		reader, err := core.OpenIndexReader(indexDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, i := range []int64{9, 0, 5, 4, 3} { // across blocks, out of order
+			doc, err := reader.Document(i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if doc.GetValues("body")[0] != strings.Repeat(line, int(i)+1) {
+				t.Errorf("%s: body of doc %d", name, i)
+			}
+			if thumb := doc.GetFields("thumb")[0].BinaryValue(); !bytes.Equal(thumb, []byte{byte(i)}) {
+				t.Errorf("%s: thumb of doc %d is %v", name, i, thumb)
+			}
+		}
+		reader.Close()
+
+		files, err := ioutil.ReadDir(indexDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, f := range files {
+			if path.Ext(f.Name()) == ".fdt" {
+				fdtSize[name] = fdtSize[name] + f.Size()
+			}
+		}
+	}
+	if fdtSize["fast"]*4 > fdtSize["none"] || fdtSize["high"] > fdtSize["fast"] {
+		t.Errorf("fdt sizes %v", fdtSize)
+	}
+}
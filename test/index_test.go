@@ -1,58 +1,553 @@
 package test
 
 import (
-	"fmt"
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/Kua-Fu/gsearch/core"
 )
 
 func TestDoc(t *testing.T) {
-	var (
-		err      error
-		indexDir string
-	)
-
-	indexDir = "/Users/yz/work/github/gsearch/test/index/"
-
-	// fPtr, err = core.CreateFile(indexDir, true, true)
-	// if err != nil {
-	// 	t.Error(err)
-	// }
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
 
-	analyzer := new(core.Analyzer)
 	writer := new(core.DocumentWriter)
-	writer.Init(indexDir, *analyzer, int64(1000))
+	writer.Init(indexDir, core.StandardAnalyzer{}, int64(1000))
 
-	doc := new(core.Document)
+	doc := core.Document{}
 	f1, err := core.Keyword("path", "/etc/test.txt")
 	if err != nil {
-		t.Errorf("doc index err")
+		t.Fatal(err)
 	}
 	doc.Add(f1)
 
-	segment := "s1"
-	fmt.Println("--test doc--")
-	writer.AddDocument(segment, *doc)
-
+	_, err = writer.AddDocument("s1", doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored, err := ioutil.ReadFile(path.Join(indexDir, "s1.fdt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(stored, []byte("/etc/test.txt")) {
+		t.Errorf("path not stored")
+	}
 }
 
 func TestIndex(t *testing.T) {
-	var (
-		indexDir string
-	)
-
-	indexDir = "/Users/yz/work/github/gsearch/test/index1/"
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
 
-	analyzer := new(core.Analyzer)
 	writer := new(core.Writer)
-	writer.Init(indexDir, *analyzer, true)
-
-	doc := new(core.Document)
+	err = writer.Init(indexDir, core.StandardAnalyzer{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := core.Document{}
 	f1, _ := core.Keyword("path", "/etc/test.txt")
 	doc.Add(f1)
+	err = writer.AddDocument(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = writer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	if reader.MaxDoc() != 1 || reader.DocFreq("path", "/etc/test.txt") != 1 {
+		t.Errorf("got %d docs, path in %d", reader.MaxDoc(), reader.DocFreq("path", "/etc/test.txt"))
+	}
+}
+
+func TestTermVector(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	writer := new(core.Writer)
+	err = writer.Init(indexDir, core.StandardAnalyzer{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, text := range []string{"bright moon, bright frost", "spring dawn", "quiet night"} {
+		doc := core.Document{}
+		body, _ := core.Text("body", text)
+		if i == 0 {
+			body.SetTermVector(core.TermVectorWithPositionsOffsets)
+		} else if i == 1 {
+			body.SetTermVector(core.TermVectorYes)
+		}
+		author, _ := core.Keyword("author", "li bai")
+		doc.Add(body)
+		doc.Add(author)
+		writer.AddDocument(doc)
+	}
+	writer.Close()
+
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	v, err := reader.TermVector(0, "body")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v == nil || !reflect.DeepEqual(v.Terms, []string{"bright", "frost", "moon"}) {
+		t.Fatalf("vector %+v", v)
+	}
+	i := v.IndexOf("bright")
+	if v.Freqs[i] != 2 || !reflect.DeepEqual(v.Positions[i], []int64{0, 2}) {
+		t.Errorf("bright freq %d positions %v", v.Freqs[i], v.Positions[i])
+	}
+	want := []core.TermVectorOffsetInfo{{StartOffset: 0, EndOffset: 6}, {StartOffset: 13, EndOffset: 19}}
+	if !reflect.DeepEqual(v.Offsets[i], want) {
+		t.Errorf("bright offsets %v", v.Offsets[i])
+	}
+	if v.IndexOf("night") != -1 {
+		t.Errorf("night in vector of doc 0")
+	}
+
+	v, err = reader.TermVector(1, "body")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v == nil || !reflect.DeepEqual(v.Terms, []string{"dawn", "spring"}) || v.Positions != nil || v.Offsets != nil {
+		t.Errorf("vector without positions %+v", v)
+	}
+
+	for _, c := range []struct {
+		doc   int64
+		field string
+	}{{2, "body"}, {0, "author"}} {
+		v, err = reader.TermVector(c.doc, c.field)
+		if err != nil || v != nil {
+			t.Errorf("doc %d %s: vector %+v, err %v", c.doc, c.field, v, err)
+		}
+	}
+
+	f, _ := core.UnIndexed("note", "x")
+	if f.SetTermVector(core.TermVectorYes) == nil {
+		t.Errorf("expected an error for a vector of an unindexed field")
+	}
+}
+
+func TestDocValues(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	dynasties := []string{"tang", "song", "tang", "yuan"}
+	config := core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	config.MaxBufferedDocs = 1 // a segment per document, more than MergeFactor are merged twice
+	writer, err := core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 12; i++ {
+		doc := core.Document{}
+		title, _ := core.Text("title", "poem "+strconv.Itoa(i))
+		doc.Add(title)
+		if i%3 != 2 { // every third poem has no year, dynasty or cover
+			year, _ := core.NumericDocValuesField("year", int64(700+i))
+			dynasty, _ := core.SortedDocValuesField("dynasty", dynasties[i%4])
+			cover, _ := core.BinaryDocValuesField("cover", []byte{byte(i)})
+			doc.Add(year)
+			doc.Add(dynasty)
+			doc.Add(cover)
+		}
+		for _, tag := range []string{"moon", "river", "moon"}[:i%3+1] {
+			f, _ := core.SortedSetDocValuesField("tags", tag)
+			doc.Add(f)
+		}
+		writer.AddDocument(doc)
+	}
+	writer.Close()
+
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	if reader.MaxDoc() != 12 {
+		t.Fatalf("got %d docs, want 12", reader.MaxDoc())
+	}
+	years, err := reader.NumericDocValues("year")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dynasty, err := reader.SortedDocValues("dynasty")
+	if err != nil {
+		t.Fatal(err)
+	}
+	covers, err := reader.BinaryDocValues("cover")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tags, err := reader.SortedSetDocValues("tags")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dynasty.ValueCount() != 3 || dynasty.LookupOrd(0) != "song" || tags.ValueCount() != 2 {
+		t.Errorf("value counts %d %d", dynasty.ValueCount(), tags.ValueCount())
+	}
+
+	for i := int64(0); i < reader.MaxDoc(); i++ {
+		year, found := years.Get(i)
+		value, hasDynasty := dynasty.Get(i)
+		cover, hasCover := covers.Get(i)
+		if i%3 == 2 {
+			if found || hasDynasty || hasCover || dynasty.Ord(i) != -1 {
+				t.Errorf("doc %d: unexpected values", i)
+			}
+		} else if year != 700+i || value != dynasties[i%4] || !bytes.Equal(cover, []byte{byte(i)}) {
+			t.Errorf("doc %d: year %d dynasty %s cover %v", i, year, value, cover)
+		}
+		want := []string{"moon", "moon river", "moon river"}[i%3]
+		if got := strings.Join(tags.Get(i), " "); got != want {
+			t.Errorf("doc %d: tags %q, want %q", i, got, want)
+		}
+	}
+
+	if _, err := reader.NumericDocValues("dynasty"); err == nil {
+		t.Errorf("expected an error for numeric values of a sorted field")
+	}
+	doc := core.Document{}
+	y1, _ := core.NumericDocValuesField("year", 701)
+	y2, _ := core.NumericDocValuesField("year", 702)
+	doc.Add(y1)
+	doc.Add(y2)
+	dw := new(core.DocumentWriter)
+	dw.Init(indexDir, core.StandardAnalyzer{}, 10000)
+	if _, err := dw.AddDocument("twice", doc); err == nil {
+		t.Errorf("expected an error for two numeric values")
+	}
+}
+
+func TestRAMBuffer(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
 
-	writer.AddDocument(*doc)
+	config := core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	config.MaxBufferedDocs = 4 // segments of 4, 4 and 1 documents, too few to merge
+	writer, err := core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 9; i++ {
+		doc := core.Document{}
+		f, _ := core.Text("title", "poem "+strconv.Itoa(i))
+		doc.Add(f)
+		f, _ = core.Keyword("id", strconv.Itoa(i))
+		doc.Add(f)
+		err = writer.AddDocument(doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
 	writer.Close()
 
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reader.Segments()) != 3 || reader.MaxDoc() != 9 {
+		t.Fatalf("got %d segments of %d docs", len(reader.Segments()), reader.MaxDoc())
+	}
+	for i := 0; i < 9; i++ {
+		termDocs, err := reader.TermDocs("id", strconv.Itoa(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		doc, err := reader.Document(int64(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(termDocs) != 1 || termDocs[0].Doc != int64(i) || doc.GetValues("id")[0] != strconv.Itoa(i) {
+			t.Fatalf("doc %d: got term docs %v, doc %v", i, termDocs, doc.GetValues("id"))
+		}
+	}
+	reader.Close()
+
+	// a tiny RAM budget flushes every document, the merge deletes the merged segments
+	config = core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	config.OpenMode = core.OpenModeAppend
+	config.RAMBufferSize = 1
+	writer, err = core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 9; i < 12; i++ {
+		doc := core.Document{}
+		f, _ := core.Keyword("id", strconv.Itoa(i))
+		doc.Add(f)
+		writer.AddDocument(doc)
+	}
+	writer.Close()
+
+	reader, err = core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	if len(reader.Segments()) != 3 || reader.MaxDoc() != 12 {
+		t.Fatalf("got %d segments of %d docs", len(reader.Segments()), reader.MaxDoc())
+	}
+	termDocs, err := reader.TermDocs("id", "10")
+	if err != nil || len(termDocs) != 1 || termDocs[0].Doc != 10 {
+		t.Fatalf("got term docs %v, %v", termDocs, err)
+	}
+	files, err := ioutil.ReadDir(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range files {
+		if strings.HasPrefix(f.Name(), "_1.") {
+			t.Fatalf("merged segment file %s not deleted", f.Name())
+		}
+	}
+}
+
+func TestConcurrentAddDocument(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	config := core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	config.MaxBufferedDocs = 7 // buffers are flushed and merged while others index
+	writer, err := core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const workers, perWorker = 8, 25
+	errs := make(chan error, workers)
+	for w := 0; w < workers; w++ {
+		go func(w int) {
+			for i := 0; i < perWorker; i++ {
+				doc := core.Document{}
+				f, _ := core.Keyword("id", strconv.Itoa(w*perWorker+i))
+				doc.Add(f)
+				f, _ = core.Text("title", "poem of worker "+strconv.Itoa(w))
+				doc.Add(f)
+				err := writer.AddDocument(doc)
+				if err != nil {
+					errs <- err
+					return
+				}
+			}
+			errs <- nil
+		}(w)
+	}
+	for w := 0; w < workers; w++ {
+		if err := <-errs; err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = writer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	if reader.MaxDoc() != workers*perWorker {
+		t.Fatalf("got %d docs, want %d", reader.MaxDoc(), workers*perWorker)
+	}
+	for i := 0; i < workers*perWorker; i++ {
+		termDocs, err := reader.TermDocs("id", strconv.Itoa(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(termDocs) != 1 {
+			t.Fatalf("id %d in %d docs", i, len(termDocs))
+		}
+		doc, err := reader.Document(termDocs[0].Doc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if doc.GetValues("id")[0] != strconv.Itoa(i) {
+			t.Fatalf("id %d: got doc %v", i, doc.GetValues("id"))
+		}
+	}
+	if n := reader.DocFreq("title", "poem"); n != workers*perWorker {
+		t.Fatalf("got doc freq %d", n)
+	}
+}
+
+func TestIndexWriterConfig(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	config := core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	config.OpenMode = core.OpenModeAppend
+	if _, err = core.OpenWriter(indexDir, config); err == nil {
+		t.Fatal("appended to a missing index")
+	}
+	for _, bad := range []func(c *core.IndexWriterConfig){
+		func(c *core.IndexWriterConfig) { c.RAMBufferSize, c.MaxBufferedDocs = 0, 0 },
+		func(c *core.IndexWriterConfig) { c.MaxFieldLength = 0 },
+		func(c *core.IndexWriterConfig) { c.IndexInterval = 0 },
+		func(c *core.IndexWriterConfig) { c.MergePolicy = &core.LogDocMergePolicy{MergeFactor: 1} },
+		func(c *core.IndexWriterConfig) { c.OpenMode = 7 },
+	} {
+		c := core.NewIndexWriterConfig(core.StandardAnalyzer{})
+		bad(c)
+		if _, err = core.OpenWriter(indexDir, c); err == nil {
+			t.Fatalf("invalid config %+v accepted", c)
+		}
+	}
+
+	// two writers with their own settings, the config copied when opened
+	otherDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(otherDir)
+	var log bytes.Buffer
+	config = core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	config.MaxBufferedDocs = 2
+	config.InfoStream = &log
+	config.MergeScheduler = core.NewConcurrentMergeScheduler()
+	small, err := core.OpenWriter(indexDir, config) // created, there is no index
+	if err != nil {
+		t.Fatal(err)
+	}
+	config.MaxBufferedDocs = 0
+	config.InfoStream = nil
+	config.MergePolicy.(*core.LogDocMergePolicy).MergeFactor = 100
+	large, err := core.OpenWriter(otherDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if small.Config().MaxBufferedDocs != 2 || small.Config().MergePolicy.(*core.LogDocMergePolicy).MergeFactor != 10 {
+		t.Fatal("writer config changed with the config it was opened with")
+	}
+	smallScheduler, _ := small.Config().MergeScheduler.(*core.ConcurrentMergeScheduler)
+	largeScheduler, _ := large.Config().MergeScheduler.(*core.ConcurrentMergeScheduler)
+	if smallScheduler == nil || largeScheduler == nil || smallScheduler == largeScheduler || smallScheduler == config.MergeScheduler ||
+		smallScheduler.MaxThreadCount != config.MergeScheduler.(*core.ConcurrentMergeScheduler).MaxThreadCount {
+		t.Fatal("writers opened with one config share its merge scheduler")
+	}
+	for i := 0; i < 6; i++ {
+		doc := core.Document{}
+		f, _ := core.Text("title", "poem "+strconv.Itoa(i))
+		doc.Add(f)
+		small.AddDocument(doc)
+		large.AddDocument(doc)
+	}
+	small.Close()
+	large.Close()
+
+	for dir, segments := range map[string]int{indexDir: 3, otherDir: 1} {
+		reader, err := core.OpenIndexReader(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(reader.Segments()) != segments || reader.MaxDoc() != 6 {
+			t.Errorf("got %d segments of %d docs, want %d", len(reader.Segments()), reader.MaxDoc(), segments)
+		}
+		reader.Close()
+	}
+	if !strings.Contains(log.String(), "IW: flush segment _3 of 2 documents") {
+		t.Errorf("info stream %q", log.String())
+	}
+
+	// create or append appends to the index there
+	config = core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	writer, err := core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := core.Document{}
+	f, _ := core.Text("title", "poem 6")
+	doc.Add(f)
+	writer.AddDocument(doc)
+	writer.Close()
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	if reader.MaxDoc() != 7 {
+		t.Fatalf("got %d docs after append, want 7", reader.MaxDoc())
+	}
+}
+
+func TestFlushWriteFailure(t *testing.T) {
+	if _, err := os.Stat("/dev/full"); err != nil {
+		t.Skip("no /dev/full to fail the writes")
+	}
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	writer, err := core.OpenWriter(indexDir, core.NewIndexWriterConfig(core.StandardAnalyzer{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	frq := path.Join(indexDir, "_1.frq")
+	err = os.Symlink("/dev/full", frq) // every write to the postings of the first segment fails
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := core.Document{}
+	f, _ := core.Text("title", "bright moon")
+	doc.Add(f)
+	writer.AddDocument(doc)
+	if err = writer.Flush(); err == nil {
+		t.Fatal("segment flushed with its postings unwritten")
+	}
+
+	os.Remove(frq)
+	err = writer.Close() // the documents are still buffered, flushed as the next segment
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	termDocs, err := reader.TermDocs("title", "moon")
+	if err != nil || reader.MaxDoc() != 1 || len(termDocs) != 1 {
+		t.Fatalf("got %d docs, term docs %v, %v", reader.MaxDoc(), termDocs, err)
+	}
 }
@@ -0,0 +1,150 @@
+package test
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Kua-Fu/gsearch/core"
+)
+
+type poemSource struct {
+	Book string `poetry:"book,keyword,stored"`
+	Page *int   `poetry:"page,int,stored"`
+}
+
+type poemMeta struct {
+	Dynasty string `poetry:"dynasty,keyword,stored,sortable"`
+}
+
+type poem struct {
+	poemMeta
+	Title      string      `poetry:"title,text,stored"`
+	Paragraphs []string    `poetry:"paragraphs,text,stored"`
+	Tags       []string    `poetry:"tags,keyword,stored,sortable"`
+	Year       int         `poetry:"year,int,stored,sortable"`
+	Rating     float64     `poetry:"rating,float,stored,sortable"`
+	Famous     bool        `poetry:"famous,stored"`
+	Added      time.Time   `poetry:"added,time,stored"`
+	Cover      []byte      `poetry:"cover,binary,stored"`
+	Source     *poemSource `poetry:"source"`
+	Notes      string      `poetry:"notes,noindex,stored"`
+	Draft      string      `poetry:"-"`
+	Body       string      // text, not stored
+	internal   string
+}
+
+func TestMarshal(t *testing.T) {
+	page := 42
+	in := poem{
+		poemMeta:   poemMeta{Dynasty: "tang"},
+		Title:      "Quiet Night Thought",
+		Paragraphs: []string{"Before my bed the moonlight", "I think of home"},
+		Tags:       []string{"moon", "home"},
+		Year:       726,
+		Rating:     -4.5,
+		Famous:     true,
+		Added:      time.Date(2020, 3, 1, 8, 30, 0, 500, time.UTC),
+		Cover:      []byte{0x89, 'P', 'N', 'G'},
+		Source:     &poemSource{Book: "Three Hundred Tang Poems", Page: &page},
+		Notes:      "written in Yangzhou",
+		Draft:      "not indexed at all",
+		Body:       "full text",
+		internal:   "skipped",
+	}
+
+	doc, err := core.Marshal(&in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := map[string]int{}
+	for _, f := range doc.Fields {
+		names[f.Name()] = names[f.Name()] + 1
+	}
+	for name, n := range map[string]int{
+		"dynasty": 2, "title": 1, "paragraphs": 2, "tags": 4, "year": 2, "rating": 2,
+		"source.book": 1, "source.page": 1, "Body": 1, "Draft": 0, "internal": 0,
+	} {
+		if names[name] != n {
+			t.Errorf("%d %s fields, want %d", names[name], name, n)
+		}
+	}
+
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+	writer := new(core.Writer)
+	err = writer.Init(indexDir, core.StandardAnalyzer{}, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writer.AddDocument(doc)
+	writer.Close()
+
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	stored, err := reader.Document(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := poem{Draft: "kept"}
+	err = core.Unmarshal(stored, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := in
+	want.Draft, want.Body, want.internal = "kept", "", ""
+	if !out.Added.Equal(want.Added) {
+		t.Errorf("added %v, want %v", out.Added, want.Added)
+	}
+	out.Added = want.Added
+	if !reflect.DeepEqual(out, want) {
+		t.Errorf("unmarshal\n got %+v\nwant %+v", out, want)
+	}
+	if out.Source == nil || *out.Source.Page != 42 {
+		t.Errorf("source %+v", out.Source)
+	}
+
+	years, err := reader.NumericDocValues("year")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ratings, err := reader.NumericDocValues("rating")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tags, err := reader.SortedSetDocValues("tags")
+	if err != nil {
+		t.Fatal(err)
+	}
+	year, _ := years.Get(0)
+	rating, _ := ratings.Get(0)
+	if year != 726 || core.SortableInt64ToFloat(rating) != -4.5 || !reflect.DeepEqual(tags.Get(0), []string{"home", "moon"}) {
+		t.Errorf("doc values %d %d %v", year, rating, tags.Get(0))
+	}
+	if core.FloatToSortableInt64(-4.5) >= core.FloatToSortableInt64(-1) || core.FloatToSortableInt64(-1) >= core.FloatToSortableInt64(2) {
+		t.Errorf("sortable floats out of order")
+	}
+
+	if _, err := core.Marshal(struct {
+		Years []int `poetry:"years,int,sortable"`
+	}{[]int{1, 2}}); err == nil {
+		t.Errorf("expected an error for a sortable slice of ints")
+	}
+	if _, err := core.Marshal(struct {
+		Year string `poetry:"year,int"`
+	}{"726"}); err == nil {
+		t.Errorf("expected an error for an int kind of a string")
+	}
+	if err := core.Unmarshal(stored, out); err == nil {
+		t.Errorf("expected an error for a struct value")
+	}
+}
@@ -0,0 +1,420 @@
+package test
+
+import (
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Kua-Fu/gsearch/core"
+)
+
+// mergeAllPolicy merge every segment once there are three
+type mergeAllPolicy struct{}
+
+func (p mergeAllPolicy) FindMerges(infos *core.SegmentInfos) (*core.MergeSpecification, error) {
+	if infos.Len() < 3 {
+		return nil, nil
+	}
+	merge := core.OneMerge{}
+	for i := 0; i < infos.Len(); i++ {
+		si, _ := infos.Info(int64(i))
+		merge.Segments = append(merge.Segments, si)
+	}
+	return &core.MergeSpecification{Merges: []core.OneMerge{merge}}, nil
+}
+
+// numberedPoem document number i
+func numberedPoem(i int) core.Document {
+	doc := core.Document{}
+	f, _ := core.Keyword("id", strconv.Itoa(i))
+	doc.Add(f)
+	f, _ = core.Text("title", "poem number "+strconv.Itoa(i))
+	doc.Add(f)
+	return doc
+}
+
+// indexWithPolicy index n documents, a segment each, merged by a policy, and open the index
+func indexWithPolicy(t *testing.T, indexDir string, policy core.MergePolicy, n int) *core.IndexReader {
+	config := core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	config.MaxBufferedDocs = 1
+	if policy != nil {
+		config.MergePolicy = policy
+	}
+	writer, err := core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < n; i++ {
+		err = writer.AddDocument(numberedPoem(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = writer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return openMerged(t, indexDir, n)
+}
+
+// openMerged open an index of n poems, checking none was lost by merges
+func openMerged(t *testing.T, indexDir string, n int) *core.IndexReader {
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reader.MaxDoc() != int64(n) || reader.DocFreq("title", "poem") != int64(n) {
+		t.Fatalf("got %d docs, %d with poem", reader.MaxDoc(), reader.DocFreq("title", "poem"))
+	}
+	for i := 0; i < n; i++ {
+		if reader.DocFreq("id", strconv.Itoa(i)) != 1 {
+			t.Fatalf("id %d lost by merges", i)
+		}
+	}
+	return reader
+}
+
+func TestMergePolicy(t *testing.T) {
+	cases := []struct {
+		name     string
+		policy   core.MergePolicy
+		segments int
+	}{
+		{"log doc", nil, 7}, // 10, 10 and 5 single documents
+		{"log byte size", &core.LogByteSizeMergePolicy{MergeFactor: 3, MinMergeBytes: 1, MaxMergeBytes: 1 << 30}, 2},                        // levels of 3 times the bytes
+		{"tiered", &core.TieredMergePolicy{SegmentsPerTier: 2, MaxMergeAtOnce: 2, MaxMergedSegmentBytes: 1 << 30, FloorSegmentBytes: 1}, 5}, // 2 per tier
+		{"custom", mergeAllPolicy{}, 1}, // merged each time a third segment comes
+	}
+	for _, c := range cases {
+		indexDir, err := ioutil.TempDir("", "gsearch")
+		if err != nil {
+			t.Fatal(err)
+		}
+		reader := indexWithPolicy(t, indexDir, c.policy, 25)
+		segments := len(reader.Segments())
+		reader.Close()
+		os.RemoveAll(indexDir)
+		if segments != c.segments {
+			t.Errorf("%s: got %d segments, want %d", c.name, segments, c.segments)
+		}
+	}
+}
+
+func TestConcurrentMergeScheduler(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	scheduler := core.NewConcurrentMergeScheduler()
+	scheduler.MaxThreadCount = 2
+	scheduler.MaxMergeBytesPerSec = 1 << 20
+	config := core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	config.MaxBufferedDocs = 2
+	config.MergePolicy = &core.LogDocMergePolicy{MergeFactor: 3, MaxMergeDocs: 1 << 30}
+	config.MergeScheduler = scheduler
+	writer, err := core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w * 30; i < (w+1)*30; i++ {
+				if err := writer.AddDocument(numberedPoem(i)); err != nil {
+					t.Error(err)
+					return
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	err = writer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader := openMerged(t, indexDir, 120)
+	segments := len(reader.Segments())
+	reader.Close()
+	if segments >= 60 {
+		t.Fatalf("got %d segments, merges did not run", segments)
+	}
+
+	err = writer.Optimize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader = openMerged(t, indexDir, 120)
+	defer reader.Close()
+	if len(reader.Segments()) != 1 {
+		t.Fatalf("got %d segments after optimize", len(reader.Segments()))
+	}
+}
+
+func TestCloseAbortingMerges(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	scheduler := core.NewConcurrentMergeScheduler()
+	scheduler.MaxMergeBytesPerSec = 1 // a merge would take hours
+	config := core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	config.MaxBufferedDocs = 1
+	config.MergeScheduler = scheduler
+	writer, err := core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	for i := 0; i < 200; i++ {
+		err = writer.AddDocument(numberedPoem(i))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = writer.CloseAbortingMerges()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(start) > 10*time.Second {
+		t.Fatalf("indexing waited %v for throttled merges", time.Since(start))
+	}
+
+	reader := openMerged(t, indexDir, 200)
+	defer reader.Close()
+	if len(reader.Segments()) != 200 {
+		t.Fatalf("got %d segments, the throttled merges finished", len(reader.Segments()))
+	}
+	files, err := ioutil.ReadDir(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	segmentNames := map[string]bool{}
+	for _, file := range files {
+		if strings.HasPrefix(file.Name(), "_") {
+			segmentNames[strings.Split(file.Name(), ".")[0]] = true
+		}
+	}
+	if len(segmentNames) != 200 {
+		t.Fatalf("got files of %d segments, aborted merges left files", len(segmentNames))
+	}
+}
+
+func TestMergeCorruptStoredFields(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	reader := indexWithPolicy(t, indexDir, mergeAllPolicy{}, 2) // two segments, not merged
+	segments := len(reader.Segments())
+	reader.Close()
+	files, err := ioutil.ReadDir(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files { // cut the compressed block of each segment
+		if strings.HasSuffix(file.Name(), ".fdt") {
+			err = os.Truncate(indexDir+"/"+file.Name(), file.Size()-4)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	writer, err := core.OpenWriter(indexDir, core.NewIndexWriterConfig(core.StandardAnalyzer{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = writer.Optimize(); err == nil {
+		t.Fatal("merged unreadable stored fields")
+	}
+	writer.Close()
+	reader = openMerged(t, indexDir, 2)
+	defer reader.Close()
+	if len(reader.Segments()) != segments {
+		t.Fatalf("got %d segments, the failed merge replaced the %d", len(reader.Segments()), segments)
+	}
+}
+
+func TestMergeTruncatedNorms(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	reader := indexWithPolicy(t, indexDir, mergeAllPolicy{}, 2) // two segments, not merged
+	segments := len(reader.Segments())
+	reader.Close()
+	norms := regexp.MustCompile(`\.f[0-9]+$`)
+	files, err := ioutil.ReadDir(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		if norms.MatchString(file.Name()) {
+			err = os.Truncate(indexDir+"/"+file.Name(), 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	writer, err := core.OpenWriter(indexDir, core.NewIndexWriterConfig(core.StandardAnalyzer{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = writer.Optimize(); err == nil {
+		t.Fatal("merged truncated norms")
+	}
+	writer.Close()
+	reader = openMerged(t, indexDir, 2)
+	defer reader.Close()
+	if len(reader.Segments()) != segments {
+		t.Fatalf("got %d segments, the failed merge replaced the %d", len(reader.Segments()), segments)
+	}
+}
+
+func TestMergeCommitFailure(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	reader := indexWithPolicy(t, indexDir, mergeAllPolicy{}, 2)
+	reader.Close()
+	writer, err := core.OpenWriter(indexDir, core.NewIndexWriterConfig(core.StandardAnalyzer{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	segmentsFile := indexDir + "/segments"
+	segments, err := ioutil.ReadFile(segmentsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Remove(segmentsFile)
+	err = os.MkdirAll(segmentsFile+"/keep", 0755) // segments.new can not be renamed over a directory
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = writer.Optimize(); err == nil {
+		t.Fatal("merge committed without a segments file")
+	}
+
+	os.RemoveAll(segmentsFile)
+	err = ioutil.WriteFile(segmentsFile, segments, 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader = openMerged(t, indexDir, 2) // the merged segments were kept
+	defer reader.Close()
+	if len(reader.Segments()) != 2 {
+		t.Fatalf("got %d segments", len(reader.Segments()))
+	}
+}
+
+// noMergePolicy never merges
+type noMergePolicy struct{}
+
+func (p noMergePolicy) FindMerges(infos *core.SegmentInfos) (*core.MergeSpecification, error) {
+	return nil, nil
+}
+
+// recordingPolicy merges found by a policy, at each call
+type recordingPolicy struct {
+	core.MergePolicy
+	calls [][]core.OneMerge
+}
+
+func (p *recordingPolicy) FindMerges(infos *core.SegmentInfos) (*core.MergeSpecification, error) {
+	spec, err := p.MergePolicy.FindMerges(infos)
+	if spec != nil {
+		p.calls = append(p.calls, spec.Merges)
+	}
+	return spec, err
+}
+
+// longPoem poem number i, of many distinct terms, so the size of a segment grows with its documents
+func longPoem(i int) core.Document {
+	doc := numberedPoem(i)
+	words := []string{}
+	for k := 0; k < 200; k++ {
+		words = append(words, "w"+strconv.Itoa(i)+"x"+strconv.Itoa(k))
+	}
+	f, _ := core.Text("body", strings.Join(words, " "))
+	doc.Add(f)
+	return doc
+}
+
+func TestTieredMergePolicy(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	config := core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	config.MergePolicy = noMergePolicy{}
+	writer, err := core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n := 0
+	for _, docs := range []int{10, 5, 5, 5} { // the first segment keeps 6 of 10 documents, a little larger than the others
+		for i := 0; i < docs; i++ {
+			writer.AddDocument(longPoem(n))
+			n = n + 1
+		}
+		writer.Flush()
+	}
+	writer.DeleteDocuments(core.NewTerm("id", "0"), core.NewTerm("id", "1"), core.NewTerm("id", "2"), core.NewTerm("id", "3"))
+	writer.Close()
+
+	// every segment floored to the same size, one tier of a single segment allowed
+	policy := &recordingPolicy{MergePolicy: &core.TieredMergePolicy{
+		SegmentsPerTier: 2, MaxMergeAtOnce: 2, MaxMergedSegmentBytes: 1 << 40, FloorSegmentBytes: 1 << 30,
+	}}
+	config = core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	config.MergePolicy = policy
+	writer, err = core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writer.AddDocument(numberedPoem(n)) // a small fifth segment, asking the policy
+	writer.Flush()
+	if len(policy.calls) == 0 || len(policy.calls[0]) < 2 {
+		t.Fatalf("got merges %v, want several at once", policy.calls)
+	}
+	deleted := false
+	for _, si := range policy.calls[0][0].Segments { // rather than the smallest merge, of the small segment
+		deleted = deleted || si.DelCount() == 4
+	}
+	if !deleted {
+		t.Errorf("best merge %v leaves the segment of deleted documents", policy.calls[0][0].Segments)
+	}
+	seen := map[string]bool{}
+	for _, merge := range policy.calls[0] {
+		for _, si := range merge.Segments {
+			if seen[si.Name()] {
+				t.Fatalf("segment %s in two merges", si.Name())
+			}
+			seen[si.Name()] = true
+		}
+	}
+	writer.Close()
+}
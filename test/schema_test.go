@@ -0,0 +1,283 @@
+package test
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Kua-Fu/gsearch/core"
+)
+
+const poemSchema = `{
+	"fields": [
+		{"name": "title", "type": "text", "analyzer": "title", "stored": true, "indexed": true, "term_vector": "positions"},
+		{"name": "paragraphs", "type": "text", "stored": true, "indexed": true, "multi_valued": true},
+		{"name": "dynasty", "type": "keyword", "stored": true, "indexed": true, "doc_values": "sorted"},
+		{"name": "year", "doc_values": "numeric"},
+		{"name": "cover", "type": "binary", "stored": true}
+	]
+}`
+
+func TestSchema(t *testing.T) {
+	schema, err := core.ParseSchema(strings.NewReader(poemSchema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry := core.NewAnalyzerRegistry()
+	registry.RegisterAnalyzer("title", core.WhitespaceAnalyzer{})
+
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+	config := core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	writer, err := core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = writer.SetSchema(schema); err == nil || !strings.Contains(err.Error(), "no analyzer registry") {
+		t.Fatalf("schema naming analyzers set without a registry: %v", err)
+	}
+	writer.Close()
+	config.AnalyzerRegistry = registry
+	writer, err = core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = writer.SetSchema(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	field := func(f core.Field, err error) core.Field {
+		return f
+	}
+	title := field(core.Text("title", "Quiet Night Thought"))
+	title.SetTermVector(core.TermVectorWithPositions)
+	valid := core.Document{}
+	valid.Add(title)
+	valid.Add(field(core.Text("paragraphs", "before my bed")))
+	valid.Add(field(core.Text("paragraphs", "the moonlight")))
+	valid.Add(field(core.Keyword("dynasty", "tang")))
+	valid.Add(field(core.SortedDocValuesField("dynasty", "tang")))
+	valid.Add(field(core.NumericDocValuesField("year", 726)))
+	valid.Add(field(core.Binary("cover", []byte{1})))
+	err = writer.AddDocument(valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		field core.Field
+		err   string
+	}{
+		{field(core.Text("author", "li bai")), "unknown field author"},
+		{field(core.Keyword("title", "quiet")), "has a keyword value, declared text"},
+		{field(core.UnStored("paragraphs", "moon")), "stored false, declared true"},
+		{field(core.Text("title", "again")), "term vector"},
+		{field(core.NumericDocValuesField("dynasty", 1)), "numeric doc values"},
+		{field(core.Keyword("year", "726")), "declared doc values only"},
+		{field(core.Keyword("dynasty", "song")), "several values"},
+	}
+	for _, c := range cases {
+		doc := core.Document{}
+		doc.Add(field(core.Keyword("dynasty", "tang")))
+		doc.Add(c.field)
+		err = writer.AddDocument(doc)
+		if err == nil || !strings.Contains(err.Error(), c.err) {
+			t.Errorf("%s: error %v, want %q", c.field.Name(), err, c.err)
+		}
+	}
+	writer.Close()
+
+	// the schema is kept in the index
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reader.MaxDoc() != 1 || reader.Schema() == nil || len(reader.Schema().Fields()) != 5 {
+		t.Errorf("reopened index of %d docs, schema %v", reader.MaxDoc(), reader.Schema())
+	}
+	if reader.DocFreq("title", "Quiet") != 1 || reader.DocFreq("paragraphs", "moonlight") != 1 {
+		t.Errorf("title not analyzed by the analyzer of the schema, paragraphs not by the config's")
+	}
+	reader.Close()
+
+	writer, err = core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if writer.Schema() == nil {
+		t.Fatalf("schema not read back")
+	}
+	doc := core.Document{}
+	doc.Add(field(core.Text("author", "li bai")))
+	if writer.AddDocument(doc) == nil {
+		t.Errorf("expected an error for an unknown field after reopening")
+	}
+
+	changed, _ := core.NewSchema(core.FieldSchema{Name: "title", Type: "keyword", Indexed: true})
+	if writer.SetSchema(changed) == nil {
+		t.Errorf("expected an error for a changed schema")
+	}
+	extended, _ := core.ParseSchema(strings.NewReader(poemSchema))
+	err = extended.AddField(core.FieldSchema{Name: "author", Type: "keyword", Stored: true, Indexed: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = writer.SetSchema(extended)
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc = core.Document{}
+	doc.Add(field(core.Keyword("author", "li bai")))
+	err = writer.AddDocument(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writer.Close()
+
+	reader, err = core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	if reader.MaxDoc() != 2 {
+		t.Errorf("got %d docs, want 2", reader.MaxDoc())
+	}
+
+	for _, bad := range []core.FieldSchema{
+		{Name: "cover", Type: "binary", Indexed: true, Stored: true},
+		{Name: "note", Type: "keyword", TermVector: "positions"},
+		{Name: "year"},
+		{Name: "author", Type: "keyword", Analyzer: "title", Indexed: true},
+		{Name: "rating", Type: "float"},
+	} {
+		if _, err := core.NewSchema(bad); err == nil {
+			t.Errorf("expected an error for %+v", bad)
+		}
+	}
+}
+
+func TestSchemaOfExistingIndex(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	writer, err := core.OpenWriter(indexDir, core.NewIndexWriterConfig(core.StandardAnalyzer{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := core.Document{}
+	f, _ := core.Keyword("dynasty", "tang")
+	doc.Add(f)
+	f, _ = core.Text("title", "quiet night")
+	doc.Add(f)
+	err = writer.AddDocument(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writer.Flush()
+	doc = core.Document{}
+	f, _ = core.Keyword("author", "li bai") // still buffered
+	doc.Add(f)
+	writer.AddDocument(doc)
+
+	for _, bad := range []core.FieldSchema{
+		{Name: "dynasty", Type: "text", Stored: true, Indexed: true},
+		{Name: "title", Type: "keyword", Stored: true, Indexed: true},
+		{Name: "title", Type: "text", Stored: true, Indexed: true, TermVector: "yes"},
+		{Name: "author", Type: "text", Stored: true, Indexed: true},
+	} {
+		schema, err := core.NewSchema(bad)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = writer.SetSchema(schema); err == nil {
+			t.Errorf("schema %+v accepted for the indexed field", bad)
+		}
+	}
+	schema, err := core.NewSchema(
+		core.FieldSchema{Name: "dynasty", Type: "keyword", Stored: true, Indexed: true},
+		core.FieldSchema{Name: "title", Type: "text", Stored: true, Indexed: true},
+		core.FieldSchema{Name: "author", Type: "keyword", Stored: true, Indexed: true},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = writer.SetSchema(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writer.Close()
+}
+
+func TestSchemaWhileAdding(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+	writer, err := core.OpenWriter(indexDir, core.NewIndexWriterConfig(core.StandardAnalyzer{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// an add is held in its buffer by a reader field until its pipe is closed
+	add := func(done chan error, body io.Reader, author bool) {
+		doc := core.Document{}
+		f, _ := core.TextReader("body", body)
+		doc.Add(f)
+		if author {
+			f, _ = core.Keyword("author", "li bai")
+			doc.Add(f)
+		}
+		done <- writer.AddDocument(doc)
+	}
+	titleReader, titleWriter := io.Pipe()
+	authorReader, authorWriter := io.Pipe()
+	titleDone := make(chan error, 1)
+	authorDone := make(chan error, 1)
+	schemaDone := make(chan error, 1)
+
+	go add(titleDone, titleReader, false)
+	time.Sleep(50 * time.Millisecond)
+	schema, err := core.NewSchema(
+		core.FieldSchema{Name: "body", Type: "text", Indexed: true},
+		core.FieldSchema{Name: "author", Type: "text", Stored: true, Indexed: true},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() { schemaDone <- writer.SetSchema(schema) }() // waits for the buffer of the first add
+	time.Sleep(50 * time.Millisecond)
+	go add(authorDone, authorReader, true) // a buffer of its own, created while the schema changes
+	time.Sleep(50 * time.Millisecond)
+
+	titleWriter.Close()
+	if err = <-titleDone; err != nil {
+		t.Fatal(err)
+	}
+	schemaErr := <-schemaDone
+	authorWriter.Close()
+	authorErr := <-authorDone
+	err = writer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	if schemaErr == nil && (authorErr == nil || reader.DocFreq("author", "li bai") != 0) {
+		t.Errorf("schema set over a keyword author added while it changed: %v", authorErr)
+	}
+}
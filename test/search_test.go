@@ -0,0 +1,391 @@
+package test
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/Kua-Fu/gsearch/core"
+)
+
+func TestTermQuery(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	config := core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	config.MaxBufferedDocs = 1 // a segment per document, more than MergeFactor are merged
+	writer, err := core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 12; i++ {
+		doc := core.Document{}
+		text := "river"
+		if i%4 == 0 {
+			text = "moon moon river"
+		} else if i%4 == 1 {
+			text = "moon over the long river"
+		}
+		f, _ := core.Text("title", text)
+		doc.Add(f)
+		writer.AddDocument(doc)
+	}
+	writer.Close()
+
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+
+	if n := reader.DocFreq("title", "moon"); n != 6 {
+		t.Fatalf("got doc freq %d, want 6", n)
+	}
+	termDocs, err := reader.TermDocs("title", "moon")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(termDocs) != 6 || termDocs[4].Doc != 8 || termDocs[4].Freq != 2 ||
+		!reflect.DeepEqual(termDocs[4].Positions, []int64{0, 1}) {
+		t.Fatalf("got term docs %+v", termDocs)
+	}
+
+	searcher := core.NewIndexSearcher(reader)
+	top, err := searcher.Search(core.NewTermQuery("title", "moon"), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if top.TotalHits != 6 || len(top.ScoreDocs) != 3 {
+		t.Fatalf("got %d hits %v", top.TotalHits, top.ScoreDocs)
+	}
+	docs := []int64{}
+	for _, hit := range top.ScoreDocs {
+		docs = append(docs, hit.Doc)
+	}
+	if !reflect.DeepEqual(docs, []int64{0, 4, 8}) { // twice in a short title first
+		t.Fatalf("got hits %v", top.ScoreDocs)
+	}
+	top, err = searcher.Search(core.NewTermQuery("title", "sun"), 10)
+	if err != nil || top.TotalHits != 0 {
+		t.Fatalf("got %v, %v", top, err)
+	}
+}
+
+func TestBlockJoin(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	config := core.NewIndexWriterConfig(core.StandardAnalyzer{})
+	config.MaxBufferedDocs = 1 // a segment per block, more than MergeFactor documents are merged
+	writer, err := core.OpenWriter(indexDir, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	poems := [][]string{
+		{"quiet night thought", "moon over the mountain", "spring dawn"},
+		{"river snow"},
+		{"moon at the river", "farewell", "moon festival"},
+		{"autumn wind", "plum blossom"},
+	}
+	for i, titles := range poems {
+		block := []core.Document{}
+		for _, title := range titles {
+			doc := core.Document{}
+			f, _ := core.Text("title", title)
+			doc.Add(f)
+			block = append(block, doc)
+		}
+		anthology := core.Document{}
+		f, _ := core.Keyword("type", "anthology")
+		anthology.Add(f)
+		f, _ = core.Keyword("name", "anthology "+strconv.Itoa(i))
+		anthology.Add(f)
+		block = append(block, anthology)
+		err = writer.AddDocuments(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	writer.Close()
+
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	if reader.MaxDoc() != 13 {
+		t.Fatalf("got %d docs, want 13", reader.MaxDoc())
+	}
+
+	parents := core.NewQueryBitSetProducer(core.NewTermQuery("type", "anthology"))
+	query := core.NewToParentBlockJoinQuery(core.NewTermQuery("title", "moon"), parents, core.ScoreModeTotal)
+	groups, err := query.Groups(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(groups) != 2 || groups[0].Parent.Doc != 3 || groups[1].Parent.Doc != 9 {
+		t.Fatalf("got groups %+v", groups)
+	}
+	children := []int64{}
+	for _, child := range groups[1].Children {
+		children = append(children, child.Doc)
+	}
+	if !reflect.DeepEqual(children, []int64{6, 8}) {
+		t.Fatalf("got children %v, want [6 8]", children)
+	}
+	if groups[1].Parent.Score <= groups[0].Parent.Score {
+		t.Fatalf("two moons score %v, one %v", groups[1].Parent.Score, groups[0].Parent.Score)
+	}
+	doc, err := reader.Document(groups[1].Parent.Doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name := doc.GetValues("name"); len(name) != 1 || name[0] != "anthology 2" {
+		t.Fatalf("got anthology %v", name)
+	}
+
+	searcher := core.NewIndexSearcher(reader)
+	top, err := searcher.Search(query, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if top.TotalHits != 2 || top.ScoreDocs[0].Doc != 9 {
+		t.Fatalf("got hits %v", top.ScoreDocs)
+	}
+
+	childQuery := core.NewToChildBlockJoinQuery(core.NewTermQuery("name", "anthology 2"), parents)
+	hits, err := childQuery.Matches(reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	children = []int64{}
+	for _, hit := range hits {
+		children = append(children, hit.Doc)
+	}
+	if !reflect.DeepEqual(children, []int64{6, 7, 8}) {
+		t.Fatalf("got children %v, want [6 7 8]", children)
+	}
+
+	bad := core.NewToParentBlockJoinQuery(core.NewTermQuery("type", "anthology"), parents, core.ScoreModeMax)
+	if _, err = bad.Matches(reader); err == nil {
+		t.Fatal("child query matching parents accepted")
+	}
+	bad2 := core.NewToChildBlockJoinQuery(core.NewTermQuery("title", "moon"), parents)
+	if _, err = bad2.Matches(reader); err == nil {
+		t.Fatal("parent query matching children accepted")
+	}
+}
+
+func TestQueryParser(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	writer, err := core.OpenWriter(indexDir, core.NewIndexWriterConfig(core.StandardAnalyzer{}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, text := range []string{"一日看尽长安花", "望京城", "城京", "明月照京城"} {
+		doc := core.Document{}
+		f, _ := core.Text("paragraphs", text)
+		doc.Add(f)
+		if text == "明月照京城" {
+			f, _ = core.Text("title", "quiet night")
+			doc.Add(f)
+		}
+		writer.AddDocument(doc)
+	}
+	writer.Close()
+
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	searcher := core.NewIndexSearcher(reader)
+
+	synonyms := core.NewSynonymMap(core.StandardAnalyzer{}, true)
+	err = synonyms.ReadSolr(strings.NewReader("长安, 京城\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parser := core.NewQueryParser("paragraphs", &core.CustomAnalyzer{
+		Filters: []core.TokenFilterFactory{
+			func(input core.TokenStream) core.TokenStream { return core.NewLowerCaseFilter(input) },
+			func(input core.TokenStream) core.TokenStream { return core.NewSynonymFilter(input, synonyms) },
+		},
+	})
+	search := func(text string) []int64 {
+		query, err := parser.Parse(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		top, err := searcher.Search(query, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		docs := []int64{}
+		for _, hit := range top.ScoreDocs {
+			docs = append(docs, hit.Doc)
+		}
+		sort.Slice(docs, func(i, j int) bool { return docs[i] < docs[j] })
+		return docs
+	}
+
+	cases := []struct {
+		text string
+		and  bool
+		docs []int64
+	}{
+		{"长安", false, []int64{0, 1, 3}}, // 京城 is a synonym, not 城京
+		{`"京城"`, false, []int64{0, 1, 3}},
+		{"明月 长安", false, []int64{0, 1, 3}},
+		{"明月 长安", true, []int64{3}},
+		{`title:"quiet night"`, false, []int64{3}},
+		{`title:"night quiet"`, false, []int64{}},
+		{"title:Night 长安", true, []int64{3}},
+	}
+	for _, c := range cases {
+		parser.DefaultOperator = core.OperatorOr
+		if c.and {
+			parser.DefaultOperator = core.OperatorAnd
+		}
+		if docs := search(c.text); !reflect.DeepEqual(docs, c.docs) {
+			t.Errorf("%s: got %v, want %v", c.text, docs, c.docs)
+		}
+	}
+	if _, err = parser.Parse(`"长安`); err == nil {
+		t.Errorf("expected an error for an unterminated phrase")
+	}
+}
+
+func TestSynonymSearch(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	synonyms := core.NewSynonymMap(core.StandardAnalyzer{}, true)
+	err = synonyms.ReadSolr(strings.NewReader("长安, 京城\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	graph := &core.CustomAnalyzer{
+		Filters: []core.TokenFilterFactory{
+			func(input core.TokenStream) core.TokenStream { return core.NewLowerCaseFilter(input) },
+			func(input core.TokenStream) core.TokenStream { return core.NewSynonymFilter(input, synonyms) },
+		},
+	}
+	flat := &core.CustomAnalyzer{
+		Filters: append(graph.Filters, func(input core.TokenStream) core.TokenStream {
+			return core.NewFlattenGraphFilter(input)
+		}),
+	}
+
+	// the index has the synonyms, flattened, the query their graph
+	writer, err := core.OpenWriter(indexDir, core.NewIndexWriterConfig(flat))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, text := range []string{"望长安", "望京城", "城京安长", "明月"} {
+		doc := core.Document{}
+		f, _ := core.Text("paragraphs", text)
+		doc.Add(f)
+		writer.AddDocument(doc)
+	}
+	writer.Close()
+
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	searcher := core.NewIndexSearcher(reader)
+
+	parser := core.NewQueryParser("paragraphs", graph)
+	for _, text := range []string{"长安", "京城", `"望长安"`, `"望京城"`} {
+		query, err := parser.Parse(text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		top, err := searcher.Search(query, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		docs := []int64{}
+		for _, hit := range top.ScoreDocs {
+			docs = append(docs, hit.Doc)
+		}
+		sort.Slice(docs, func(i, j int) bool { return docs[i] < docs[j] })
+		if !reflect.DeepEqual(docs, []int64{0, 1}) {
+			t.Errorf("%s: got %v, want [0 1]", text, docs)
+		}
+	}
+}
+
+func TestStackedFirstToken(t *testing.T) {
+	indexDir, err := ioutil.TempDir("", "gsearch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(indexDir)
+
+	stacked := &core.CustomAnalyzer{
+		Filters: []core.TokenFilterFactory{
+			func(input core.TokenStream) core.TokenStream {
+				input.Close()
+				return &sliceStream{tokens: []core.Token{
+					{TermText: "moon", PositionIncrement: 0, PositionLength: 1},
+					{TermText: "luna", PositionIncrement: 0, PositionLength: 1},
+					{TermText: "rise", PositionIncrement: 1, PositionLength: 1},
+				}}
+			},
+		},
+	}
+	writer, err := core.OpenWriter(indexDir, core.NewIndexWriterConfig(stacked))
+	if err != nil {
+		t.Fatal(err)
+	}
+	doc := core.Document{}
+	f, _ := core.Text("title", "moon rise")
+	doc.Add(f)
+	writer.AddDocument(doc)
+	writer.Close()
+
+	reader, err := core.OpenIndexReader(indexDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reader.Close()
+	for text, position := range map[string]int64{"moon": 0, "luna": 0, "rise": 1} {
+		termDocs, err := reader.TermDocs("title", text)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(termDocs) != 1 || !reflect.DeepEqual(termDocs[0].Positions, []int64{position}) {
+			t.Errorf("%s: got term docs %+v, want position %d", text, termDocs, position)
+		}
+	}
+
+	query, err := core.NewQueryParser("title", stacked).Parse(`"moon rise"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	top, err := core.NewIndexSearcher(reader).Search(query, 10)
+	if err != nil || top.TotalHits != 1 {
+		t.Errorf("phrase of the stacked first token: got %v, %v", top, err)
+	}
+}